@@ -0,0 +1,158 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/x509"
+	"embed"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/fs"
+	"path"
+	"sync"
+)
+
+// appleCAFiles holds the Apple WWDR intermediate and root certificates in PEM
+// format. They are embedded so certificate chains can be built offline,
+// without fetching the AIA URLs in certificate_ca_issuers.
+// Run scripts/update-apple-ca.sh to refresh them.
+//
+//go:embed apple_ca
+var appleCAFiles embed.FS
+
+// maxCertificateChainLength guards against loops in the embedded certificates.
+const maxCertificateChainLength = 5
+
+// appleCACertificates parses the embedded Apple CA certificates once.
+var appleCACertificates = sync.OnceValues(func() ([]*x509.Certificate, error) {
+	return loadCACertificates(appleCAFiles, "apple_ca")
+})
+
+// loadCACertificates parses every PEM file in dir of fsys.
+func loadCACertificates(fsys fs.FS, dir string) ([]*x509.Certificate, error) {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	for _, file := range files {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate in %s: %w", file, err)
+			}
+			certs = append(certs, cert)
+		}
+	}
+
+	return certs, nil
+}
+
+// buildCertificateChain returns the issuing chain of leaf, starting with its
+// intermediate and ending with the root, selected from candidates by matching
+// each certificate's AuthorityKeyId against the candidate's SubjectKeyId.
+// The leaf itself is not included. The chain stops early when no issuer is found.
+func buildCertificateChain(leaf *x509.Certificate, candidates []*x509.Certificate) []*x509.Certificate {
+	var chain []*x509.Certificate
+
+	current := leaf
+	for len(chain) < maxCertificateChainLength {
+		// Self-signed certificates terminate the chain
+		if isSelfSigned(current) {
+			break
+		}
+
+		var issuer *x509.Certificate
+		for _, candidate := range candidates {
+			if bytes.Equal(candidate.SubjectKeyId, current.AuthorityKeyId) && current.CheckSignatureFrom(candidate) == nil {
+				issuer = candidate
+				break
+			}
+		}
+		if issuer == nil {
+			break
+		}
+
+		chain = append(chain, issuer)
+		current = issuer
+	}
+
+	return chain
+}
+
+// isSelfSigned reports whether cert is a root, judged by its key identifiers.
+func isSelfSigned(cert *x509.Certificate) bool {
+	return len(cert.AuthorityKeyId) == 0 || bytes.Equal(cert.AuthorityKeyId, cert.SubjectKeyId)
+}
+
+// checkAppleCertificateChains returns an error unless the embedded Apple CA
+// certificates can provide a certificate chain. The issuer of a certificate is only
+// known once Apple has issued it, so this is the check that can be made before
+// requesting one.
+func checkAppleCertificateChains() error {
+	certs, err := appleCACertificates()
+	if err != nil {
+		return fmt.Errorf("failed to load Apple CA certificates: %w", err)
+	}
+
+	return checkCertificateChains(certs)
+}
+
+// checkCertificateChains returns an error unless certs contain at least one
+// intermediate that chains to a root in certs.
+func checkCertificateChains(certs []*x509.Certificate) error {
+	for _, cert := range certs {
+		if isSelfSigned(cert) {
+			continue
+		}
+		if chain := buildCertificateChain(cert, certs); len(chain) > 0 && isSelfSigned(chain[len(chain)-1]) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no Apple WWDR intermediate certificate that chains to an Apple root certificate is embedded in the provider")
+}
+
+// appleCertificateChain builds the issuing chain for a base64 encoded DER
+// certificate from the embedded Apple CA certificates.
+func appleCertificateChain(base64DER string) ([]*x509.Certificate, error) {
+	derBytes, err := base64.StdEncoding.DecodeString(base64DER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 certificate: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	candidates, err := appleCACertificates()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Apple CA certificates: %w", err)
+	}
+
+	return buildCertificateChain(leaf, candidates), nil
+}
+
+// encodeCertificateChainPEM concatenates the chain in PEM format and base64
+// encodes it, matching certificate_content_pem.
+func encodeCertificateChainPEM(chain []*x509.Certificate) string {
+	var buf bytes.Buffer
+	for _, cert := range chain {
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
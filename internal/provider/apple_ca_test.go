@@ -0,0 +1,252 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// testCA is a certificate authority used to issue test certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// issueTestCertificate issues a certificate for commonName signed by parent,
+// or a self-signed certificate when parent is nil.
+func issueTestCertificate(t *testing.T, commonName string, isCA bool, parent *testCA) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	issuerCert, issuerKey := template, key
+	if parent != nil {
+		issuerCert, issuerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuerCert, &key.PublicKey, issuerKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+
+	return &testCA{cert: cert, key: key}
+}
+
+func TestBuildCertificateChain(t *testing.T) {
+	root := issueTestCertificate(t, "Test Root CA", true, nil)
+	intermediate := issueTestCertificate(t, "Test WWDR G4", true, root)
+	otherRoot := issueTestCertificate(t, "Other Root CA", true, nil)
+	otherIntermediate := issueTestCertificate(t, "Test WWDR G6", true, otherRoot)
+	leaf := issueTestCertificate(t, "Pass Type ID: pass.io.truetickets.test", false, intermediate)
+
+	candidates := []*x509.Certificate{otherRoot.cert, otherIntermediate.cert, root.cert, intermediate.cert}
+
+	chain := buildCertificateChain(leaf.cert, candidates)
+	if len(chain) != 2 {
+		t.Fatalf("len(chain) = %d, want 2", len(chain))
+	}
+	if !chain[0].Equal(intermediate.cert) {
+		t.Errorf("chain[0] = %s, want the issuing intermediate", chain[0].Subject.CommonName)
+	}
+	if !chain[1].Equal(root.cert) {
+		t.Errorf("chain[1] = %s, want the root", chain[1].Subject.CommonName)
+	}
+}
+
+func TestBuildCertificateChain_UnknownIssuer(t *testing.T) {
+	root := issueTestCertificate(t, "Test Root CA", true, nil)
+	intermediate := issueTestCertificate(t, "Test WWDR G4", true, root)
+	otherRoot := issueTestCertificate(t, "Other Root CA", true, nil)
+	leaf := issueTestCertificate(t, "Pass Type ID: pass.io.truetickets.test", false, intermediate)
+
+	if chain := buildCertificateChain(leaf.cert, []*x509.Certificate{otherRoot.cert}); len(chain) != 0 {
+		t.Errorf("len(chain) = %d, want 0", len(chain))
+	}
+
+	// Without the root, the chain stops at the intermediate
+	chain := buildCertificateChain(leaf.cert, []*x509.Certificate{intermediate.cert})
+	if len(chain) != 1 || !chain[0].Equal(intermediate.cert) {
+		t.Errorf("chain = %v, want only the intermediate", chain)
+	}
+}
+
+func TestLoadCACertificates(t *testing.T) {
+	root := issueTestCertificate(t, "Test Root CA", true, nil)
+	intermediate := issueTestCertificate(t, "Test WWDR G4", true, root)
+
+	bundle := append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: intermediate.cert.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.cert.Raw})...,
+	)
+
+	fsys := fstest.MapFS{
+		"certs/README.md":  {Data: []byte("not a certificate")},
+		"certs/bundle.pem": {Data: bundle},
+	}
+
+	certs, err := loadCACertificates(fsys, "certs")
+	if err != nil {
+		t.Fatalf("loadCACertificates() error = %v", err)
+	}
+	if len(certs) != 2 {
+		t.Fatalf("len(certs) = %d, want 2", len(certs))
+	}
+
+	fsys["certs/invalid.pem"] = &fstest.MapFile{Data: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")})}
+	if _, err := loadCACertificates(fsys, "certs"); err == nil {
+		t.Error("Expected error for invalid certificate, got nil")
+	}
+}
+
+func TestAppleCACertificates(t *testing.T) {
+	certs, err := appleCACertificates()
+	if err != nil {
+		t.Fatalf("appleCACertificates() error = %v", err)
+	}
+
+	for _, cert := range certs {
+		if !cert.IsCA {
+			t.Errorf("Embedded certificate %q is not a CA certificate", cert.Subject.CommonName)
+		}
+	}
+}
+
+// TestAppleCACertificates_BuildChains checks that every embedded intermediate chains
+// to an embedded root, as a certificate issued by it would.
+func TestAppleCACertificates_BuildChains(t *testing.T) {
+	certs, err := appleCACertificates()
+	if err != nil {
+		t.Fatalf("appleCACertificates() error = %v", err)
+	}
+	if len(certs) == 0 {
+		t.Skip("No Apple CA certificates are embedded; run scripts/update-apple-ca.sh")
+	}
+
+	for _, cert := range certs {
+		if isSelfSigned(cert) {
+			continue
+		}
+
+		chain := buildCertificateChain(cert, certs)
+		if len(chain) == 0 || !isSelfSigned(chain[len(chain)-1]) {
+			t.Errorf("Embedded certificate %q does not chain to an embedded root", cert.Subject.CommonName)
+		}
+	}
+
+	if err := checkAppleCertificateChains(); err != nil {
+		t.Errorf("checkAppleCertificateChains() error = %v", err)
+	}
+}
+
+func TestCheckCertificateChains(t *testing.T) {
+	root := issueTestCertificate(t, "Test Root CA", true, nil)
+	intermediate := issueTestCertificate(t, "Test WWDR G4", true, root)
+
+	tests := []struct {
+		name    string
+		certs   []*x509.Certificate
+		wantErr bool
+	}{
+		{name: "empty", wantErr: true},
+		{name: "root only", certs: []*x509.Certificate{root.cert}, wantErr: true},
+		{name: "intermediate only", certs: []*x509.Certificate{intermediate.cert}, wantErr: true},
+		{name: "intermediate and root", certs: []*x509.Certificate{root.cert, intermediate.cert}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkCertificateChains(tt.certs); (err != nil) != tt.wantErr {
+				t.Errorf("checkCertificateChains() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeneratePKCS12Bundle_WithChain(t *testing.T) {
+	root := issueTestCertificate(t, "Test Root CA", true, nil)
+	intermediate := issueTestCertificate(t, "Test WWDR G4", true, root)
+	leaf := issueTestCertificate(t, "Pass Type ID: pass.io.truetickets.test", false, intermediate)
+
+	keyDER, err := x509.MarshalECPrivateKey(leaf.key)
+	if err != nil {
+		t.Fatalf("Failed to marshal private key: %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.cert.Raw})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	chain := []*x509.Certificate{intermediate.cert, root.cert}
+	bundle, err := generatePKCS12Bundle(string(certPEM), string(keyPEM), "password", PKCS12EncodingModern, chain)
+	if err != nil {
+		t.Fatalf("generatePKCS12Bundle() error = %v", err)
+	}
+
+	p12, err := base64.StdEncoding.DecodeString(bundle)
+	if err != nil {
+		t.Fatalf("Bundle is not valid base64: %v", err)
+	}
+
+	_, cert, caCerts, err := pkcs12.DecodeChain(p12, "password")
+	if err != nil {
+		t.Fatalf("Failed to decode PKCS12 bundle: %v", err)
+	}
+	if !cert.Equal(leaf.cert) {
+		t.Error("Decoded certificate does not match the leaf certificate")
+	}
+	if len(caCerts) != 2 || !caCerts[0].Equal(intermediate.cert) || !caCerts[1].Equal(root.cert) {
+		t.Errorf("Decoded %d CA certificates, want the intermediate and root", len(caCerts))
+	}
+}
+
+func TestEncodeCertificateChainPEM(t *testing.T) {
+	root := issueTestCertificate(t, "Test Root CA", true, nil)
+	intermediate := issueTestCertificate(t, "Test WWDR G4", true, root)
+
+	encoded := encodeCertificateChainPEM([]*x509.Certificate{intermediate.cert, root.cert})
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("Chain is not valid base64: %v", err)
+	}
+
+	var got []*x509.Certificate
+	for block, rest := pem.Decode(decoded); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Fatalf("Failed to parse certificate: %v", err)
+		}
+		got = append(got, cert)
+	}
+
+	if len(got) != 2 || !got[0].Equal(intermediate.cert) || !got[1].Equal(root.cert) {
+		t.Errorf("Decoded %d certificates, want the intermediate followed by the root", len(got))
+	}
+}
@@ -0,0 +1,557 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BundleIDCapabilityResource{}
+var _ resource.ResourceWithImportState = &BundleIDCapabilityResource{}
+
+// bundleIDCapabilityErrorPointers maps JSON:API error source pointers to resource attributes.
+var bundleIDCapabilityErrorPointers = map[string]path.Path{
+	"/data/attributes/capabilityType": path.Root("capability_type"),
+	"/data/attributes/settings":       path.Root("settings"),
+	"/data/relationships/bundleId":    path.Root("bundle_id"),
+}
+
+// capabilityOptionAttrTypes describes the attribute types of a capability option.
+var capabilityOptionAttrTypes = map[string]attr.Type{
+	"key":     types.StringType,
+	"enabled": types.BoolType,
+}
+
+// capabilitySettingAttrTypes describes the attribute types of a capability setting.
+var capabilitySettingAttrTypes = map[string]attr.Type{
+	"key": types.StringType,
+	"options": types.ListType{
+		ElemType: types.ObjectType{AttrTypes: capabilityOptionAttrTypes},
+	},
+}
+
+// NewBundleIDCapabilityResource creates a new Bundle ID capability resource.
+func NewBundleIDCapabilityResource() resource.Resource {
+	return &BundleIDCapabilityResource{}
+}
+
+// BundleIDCapabilityResource defines the resource implementation.
+type BundleIDCapabilityResource struct {
+	client *Client
+}
+
+// BundleIDCapabilityResourceModel describes the resource data model.
+type BundleIDCapabilityResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	BundleID       types.String `tfsdk:"bundle_id"`
+	CapabilityType types.String `tfsdk:"capability_type"`
+	Settings       types.List   `tfsdk:"settings"`
+}
+
+// CapabilitySettingModel describes a capability setting.
+type CapabilitySettingModel struct {
+	Key     types.String `tfsdk:"key"`
+	Options types.List   `tfsdk:"options"`
+}
+
+// CapabilityOptionModel describes an option of a capability setting.
+type CapabilityOptionModel struct {
+	Key     types.String `tfsdk:"key"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *BundleIDCapabilityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle_id_capability"
+}
+
+func (r *BundleIDCapabilityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables a capability (entitlement) on a Bundle ID in App Store Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the Bundle ID capability.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bundle_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Bundle ID to enable the capability on (the resource ID, not the bundle identifier).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"capability_type": schema.StringAttribute{
+				MarkdownDescription: "The type of capability to enable. Valid values are: `" + strings.Join(capabilityTypes, "`, `") + "`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(capabilityTypes...),
+				},
+			},
+			"settings": schema.ListNestedAttribute{
+				MarkdownDescription: "Settings for the capability, such as the iCloud version or the data protection level. Changes are applied in place.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The setting key (e.g., `ICLOUD_VERSION`, `DATA_PROTECTION_PERMISSION_LEVEL`, `APPLE_ID_AUTH_APP_CONSENT`).",
+							Required:            true,
+						},
+						"options": schema.ListNestedAttribute{
+							MarkdownDescription: "The options for the setting.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "The option key (e.g., `XCODE_6`, `COMPLETE_PROTECTION`, `PRIMARY_APP_CONSENT`).",
+										Required:            true,
+									},
+									"enabled": schema.BoolAttribute{
+										MarkdownDescription: "Whether the option is enabled.",
+										Optional:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *BundleIDCapabilityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BundleIDCapabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BundleIDCapabilityResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := expandCapabilitySettings(ctx, data.Settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the request
+	createReq := BundleIDCapabilityCreateRequest{
+		Data: BundleIDCapabilityCreateRequestData{
+			Type: "bundleIdCapabilities",
+			Attributes: BundleIDCapabilityAttributes{
+				CapabilityType: data.CapabilityType.ValueString(),
+				Settings:       settings,
+			},
+			Relationships: BundleIDCapabilityCreateRequestRelationships{
+				BundleID: BundleIDCapabilityCreateRequestRelationship{
+					Data: RelationshipData{
+						Type: "bundleIds",
+						ID:   data.BundleID.ValueString(),
+					},
+				},
+			},
+		},
+	}
+
+	tflog.Debug(ctx, "Enabling Bundle ID capability", map[string]interface{}{
+		"bundle_id":       data.BundleID.ValueString(),
+		"capability_type": data.CapabilityType.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodPost,
+		Endpoint: "/bundleIdCapabilities",
+		Body:     createReq,
+	})
+	if err != nil {
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to enable Bundle ID capability", err, bundleIDCapabilityErrorPointers)
+		return
+	}
+
+	// Parse the response
+	var capability BundleIDCapability
+	if err := json.Unmarshal(apiResp.Data, &capability); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse Bundle ID capability response, got error: %s", err),
+		)
+		return
+	}
+
+	// Validate that we got an ID from the API
+	if capability.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid API Response",
+			"The API response did not contain a valid ID for the created Bundle ID capability",
+		)
+		return
+	}
+
+	// Settings are kept as planned to avoid spurious differences
+	data.ID = types.StringValue(capability.ID)
+
+	tflog.Trace(ctx, "Enabled Bundle ID capability", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BundleIDCapabilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BundleIDCapabilityResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Bundle ID capability", map[string]interface{}{
+		"bundle_id":       data.BundleID.ValueString(),
+		"capability_type": data.CapabilityType.ValueString(),
+	})
+
+	// Capabilities can only be read through their Bundle ID
+	apiResp, err := r.client.DoAll(ctx, Request{
+		Endpoint: fmt.Sprintf("/bundleIds/%s/bundleIdCapabilities", data.BundleID.ValueString()),
+	})
+	if err != nil {
+		if removeResourceIfNotFound(ctx, resp, err, "Bundle ID Capability", data.ID.ValueString()) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Bundle ID capabilities, got error: %s", err),
+		)
+		return
+	}
+
+	// Parse the response
+	var capabilities []BundleIDCapability
+	if err := json.Unmarshal(apiResp.Data, &capabilities); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse Bundle ID capabilities response, got error: %s", err),
+		)
+		return
+	}
+
+	var capability *BundleIDCapability
+	for i := range capabilities {
+		if capabilities[i].Attributes.CapabilityType == data.CapabilityType.ValueString() {
+			capability = &capabilities[i]
+			break
+		}
+	}
+
+	if capability == nil {
+		removeMissingResource(ctx, resp, "Bundle ID Capability", data.ID.ValueString())
+		return
+	}
+
+	data.ID = types.StringValue(capability.ID)
+
+	// Only refresh settings that are managed by this resource
+	if !data.Settings.IsNull() {
+		settings, diags := flattenCapabilitySettings(ctx, capability.Attributes.Settings, data.Settings)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Settings = settings
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BundleIDCapabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BundleIDCapabilityResourceModel
+	var state BundleIDCapabilityResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := expandCapabilitySettings(ctx, plan.Settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only settings can be changed in place; everything else requires replacement
+	updateReq := BundleIDCapabilityUpdateRequest{
+		Data: BundleIDCapabilityUpdateRequestData{
+			Type: "bundleIdCapabilities",
+			ID:   state.ID.ValueString(),
+			Attributes: BundleIDCapabilityAttributes{
+				CapabilityType: plan.CapabilityType.ValueString(),
+				Settings:       settings,
+			},
+		},
+	}
+
+	tflog.Debug(ctx, "Updating Bundle ID capability settings", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Make the API request
+	_, err := r.client.Do(ctx, Request{
+		Method:   http.MethodPatch,
+		Endpoint: fmt.Sprintf("/bundleIdCapabilities/%s", state.ID.ValueString()),
+		Body:     updateReq,
+	})
+	if err != nil {
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to update Bundle ID capability", err, bundleIDCapabilityErrorPointers)
+		return
+	}
+
+	plan.ID = state.ID
+
+	tflog.Trace(ctx, "Updated Bundle ID capability", map[string]interface{}{
+		"id": plan.ID.ValueString(),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BundleIDCapabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BundleIDCapabilityResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Disabling Bundle ID capability", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Make the API request
+	_, err := r.client.Do(ctx, Request{
+		Method:   http.MethodDelete,
+		Endpoint: fmt.Sprintf("/bundleIdCapabilities/%s", data.ID.ValueString()),
+	})
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to disable Bundle ID capability, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Disabled Bundle ID capability", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState imports a capability using an ID of the form "bundleId/capabilityType".
+func (r *BundleIDCapabilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bundleID, capabilityType, found := strings.Cut(req.ID, "/")
+	if !found || bundleID == "" || capabilityType == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form 'bundleId/capabilityType' (e.g., 'XXXXXXXXXX/PUSH_NOTIFICATIONS'), got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bundle_id"), bundleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("capability_type"), capabilityType)...)
+}
+
+// expandCapabilitySettings converts the settings attribute into API settings.
+func expandCapabilitySettings(ctx context.Context, list types.List) ([]CapabilitySetting, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if list.IsNull() || list.IsUnknown() {
+		return nil, diags
+	}
+
+	var settingModels []CapabilitySettingModel
+	diags.Append(list.ElementsAs(ctx, &settingModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	settings := make([]CapabilitySetting, 0, len(settingModels))
+	for _, settingModel := range settingModels {
+		setting := CapabilitySetting{
+			Key: settingModel.Key.ValueString(),
+		}
+
+		if !settingModel.Options.IsNull() && !settingModel.Options.IsUnknown() {
+			var optionModels []CapabilityOptionModel
+			diags.Append(settingModel.Options.ElementsAs(ctx, &optionModels, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			for _, optionModel := range optionModels {
+				option := CapabilityOption{
+					Key: optionModel.Key.ValueString(),
+				}
+				if !optionModel.Enabled.IsNull() && !optionModel.Enabled.IsUnknown() {
+					enabled := optionModel.Enabled.ValueBool()
+					option.Enabled = &enabled
+				}
+				setting.Options = append(setting.Options, option)
+			}
+		}
+
+		settings = append(settings, setting)
+	}
+
+	return settings, diags
+}
+
+// flattenCapabilitySettings converts API settings into the settings attribute. Only
+// the settings and options present in prior are kept, since the API also reports
+// defaults that were never configured. Options for which the API does not report an
+// enabled flag keep the value from prior.
+func flattenCapabilitySettings(ctx context.Context, settings []CapabilitySetting, prior types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settingType := types.ObjectType{AttrTypes: capabilitySettingAttrTypes}
+	optionType := types.ObjectType{AttrTypes: capabilityOptionAttrTypes}
+
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.ListNull(settingType), diags
+	}
+
+	var priorSettings []CapabilitySettingModel
+	diags.Append(prior.ElementsAs(ctx, &priorSettings, false)...)
+	if diags.HasError() {
+		return types.ListNull(settingType), diags
+	}
+
+	apiSettings := make(map[string]CapabilitySetting, len(settings))
+	for _, setting := range settings {
+		apiSettings[setting.Key] = setting
+	}
+
+	// Keep the order of prior so that reordering by the API does not show a diff
+	settingModels := make([]CapabilitySettingModel, 0, len(priorSettings))
+	for _, priorSetting := range priorSettings {
+		setting, ok := apiSettings[priorSetting.Key.ValueString()]
+		if !ok {
+			continue
+		}
+
+		settingModel := CapabilitySettingModel{
+			Key:     types.StringValue(setting.Key),
+			Options: types.ListNull(optionType),
+		}
+
+		if !priorSetting.Options.IsNull() && !priorSetting.Options.IsUnknown() {
+			var priorOptions []CapabilityOptionModel
+			diags.Append(priorSetting.Options.ElementsAs(ctx, &priorOptions, false)...)
+			if diags.HasError() {
+				return types.ListNull(settingType), diags
+			}
+
+			apiOptions := make(map[string]CapabilityOption, len(setting.Options))
+			for _, option := range setting.Options {
+				apiOptions[option.Key] = option
+			}
+
+			optionModels := make([]CapabilityOptionModel, 0, len(priorOptions))
+			for _, priorOption := range priorOptions {
+				option, ok := apiOptions[priorOption.Key.ValueString()]
+				if !ok {
+					continue
+				}
+
+				optionModel := CapabilityOptionModel{
+					Key:     types.StringValue(option.Key),
+					Enabled: priorOption.Enabled,
+				}
+				if option.Enabled != nil {
+					optionModel.Enabled = types.BoolValue(*option.Enabled)
+				}
+				optionModels = append(optionModels, optionModel)
+			}
+
+			options, d := types.ListValueFrom(ctx, optionType, optionModels)
+			diags.Append(d...)
+			if diags.HasError() {
+				return types.ListNull(settingType), diags
+			}
+			settingModel.Options = options
+		}
+
+		settingModels = append(settingModels, settingModel)
+	}
+
+	if len(settingModels) == 0 {
+		return types.ListNull(settingType), diags
+	}
+
+	list, d := types.ListValueFrom(ctx, settingType, settingModels)
+	diags.Append(d...)
+
+	return list, diags
+}
@@ -0,0 +1,233 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBundleIDCapabilityResource(t *testing.T) {
+	identifier := fmt.Sprintf("io.truetickets.test.capability%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBundleIDCapabilityResourceConfig(identifier, "COMPLETE_PROTECTION"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id_capability.test", "capability_type", CapabilityTypeDataProtection),
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id_capability.test", "settings.0.key", "DATA_PROTECTION_PERMISSION_LEVEL"),
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id_capability.test", "settings.0.options.0.key", "COMPLETE_PROTECTION"),
+					resource.TestCheckResourceAttrPair(
+						"appleappstoreconnect_bundle_id_capability.test", "bundle_id",
+						"appleappstoreconnect_bundle_id.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_bundle_id_capability.test", "id"),
+				),
+			},
+			// Update settings in place
+			{
+				Config: testAccBundleIDCapabilityResourceConfig(identifier, "PROTECTED_UNLESS_OPEN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id_capability.test", "settings.0.options.0.key", "PROTECTED_UNLESS_OPEN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBundleIDCapabilityResourceConfig(identifier, protectionLevel string) string {
+	return fmt.Sprintf(`
+resource "appleappstoreconnect_bundle_id" "test" {
+  identifier = %[1]q
+  name       = "Test Capability Bundle ID"
+  platform   = "IOS"
+}
+
+resource "appleappstoreconnect_bundle_id_capability" "test" {
+  bundle_id       = appleappstoreconnect_bundle_id.test.id
+  capability_type = "DATA_PROTECTION"
+
+  settings = [
+    {
+      key = "DATA_PROTECTION_PERMISSION_LEVEL"
+      options = [
+        {
+          key     = %[2]q
+          enabled = true
+        },
+      ]
+    },
+  ]
+}
+`, identifier, protectionLevel)
+}
+
+// testCapabilitySettingsList builds a settings attribute holding exactly settings.
+func testCapabilitySettingsList(t *testing.T, settings []CapabilitySetting) types.List {
+	t.Helper()
+	ctx := context.Background()
+	optionType := types.ObjectType{AttrTypes: capabilityOptionAttrTypes}
+
+	settingModels := make([]CapabilitySettingModel, 0, len(settings))
+	for _, setting := range settings {
+		settingModel := CapabilitySettingModel{
+			Key:     types.StringValue(setting.Key),
+			Options: types.ListNull(optionType),
+		}
+		if len(setting.Options) > 0 {
+			optionModels := make([]CapabilityOptionModel, 0, len(setting.Options))
+			for _, option := range setting.Options {
+				optionModel := CapabilityOptionModel{
+					Key:     types.StringValue(option.Key),
+					Enabled: types.BoolNull(),
+				}
+				if option.Enabled != nil {
+					optionModel.Enabled = types.BoolValue(*option.Enabled)
+				}
+				optionModels = append(optionModels, optionModel)
+			}
+			options, diags := types.ListValueFrom(ctx, optionType, optionModels)
+			if diags.HasError() {
+				t.Fatalf("ListValueFrom() diagnostics: %v", diags)
+			}
+			settingModel.Options = options
+		}
+		settingModels = append(settingModels, settingModel)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: capabilitySettingAttrTypes}, settingModels)
+	if diags.HasError() {
+		t.Fatalf("ListValueFrom() diagnostics: %v", diags)
+	}
+	return list
+}
+
+func TestCapabilitySettings_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	enabled := true
+
+	settings := []CapabilitySetting{
+		{
+			Key: "ICLOUD_VERSION",
+			Options: []CapabilityOption{
+				{Key: "XCODE_6", Enabled: &enabled},
+			},
+		},
+		{
+			Key: "APPLE_ID_AUTH_APP_CONSENT",
+		},
+	}
+
+	list, diags := flattenCapabilitySettings(ctx, settings, testCapabilitySettingsList(t, settings))
+	if diags.HasError() {
+		t.Fatalf("flattenCapabilitySettings() diagnostics: %v", diags)
+	}
+
+	got, diags := expandCapabilitySettings(ctx, list)
+	if diags.HasError() {
+		t.Fatalf("expandCapabilitySettings() diagnostics: %v", diags)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 settings, got %d", len(got))
+	}
+	if got[0].Key != "ICLOUD_VERSION" || len(got[0].Options) != 1 {
+		t.Fatalf("Unexpected first setting: %+v", got[0])
+	}
+	if got[0].Options[0].Key != "XCODE_6" || got[0].Options[0].Enabled == nil || !*got[0].Options[0].Enabled {
+		t.Errorf("Unexpected option: %+v", got[0].Options[0])
+	}
+	if got[1].Key != "APPLE_ID_AUTH_APP_CONSENT" || len(got[1].Options) != 0 {
+		t.Errorf("Unexpected second setting: %+v", got[1])
+	}
+}
+
+func TestFlattenCapabilitySettings_KeepsPriorEnabled(t *testing.T) {
+	ctx := context.Background()
+	enabled := true
+
+	prior := testCapabilitySettingsList(t, []CapabilitySetting{
+		{
+			Key: "DATA_PROTECTION_PERMISSION_LEVEL",
+			Options: []CapabilityOption{
+				{Key: "COMPLETE_PROTECTION", Enabled: &enabled},
+			},
+		},
+	})
+
+	// The API omits the enabled flag, so the prior value must be kept
+	list, diags := flattenCapabilitySettings(ctx, []CapabilitySetting{
+		{
+			Key: "DATA_PROTECTION_PERMISSION_LEVEL",
+			Options: []CapabilityOption{
+				{Key: "COMPLETE_PROTECTION"},
+			},
+		},
+	}, prior)
+	if diags.HasError() {
+		t.Fatalf("flattenCapabilitySettings() diagnostics: %v", diags)
+	}
+
+	if !list.Equal(prior) {
+		t.Errorf("Expected settings %s, got %s", prior, list)
+	}
+}
+
+func TestFlattenCapabilitySettings_OnlyManagedKeys(t *testing.T) {
+	ctx := context.Background()
+	enabled := true
+	disabled := false
+
+	prior := testCapabilitySettingsList(t, []CapabilitySetting{
+		{
+			Key: "DATA_PROTECTION_PERMISSION_LEVEL",
+			Options: []CapabilityOption{
+				{Key: "COMPLETE_PROTECTION", Enabled: &enabled},
+			},
+		},
+	})
+
+	// The API also reports other options and settings with their defaults
+	api := []CapabilitySetting{
+		{
+			Key: "ICLOUD_VERSION",
+			Options: []CapabilityOption{
+				{Key: "XCODE_6", Enabled: &enabled},
+			},
+		},
+		{
+			Key: "DATA_PROTECTION_PERMISSION_LEVEL",
+			Options: []CapabilityOption{
+				{Key: "PROTECTED_UNLESS_OPEN", Enabled: &disabled},
+				{Key: "COMPLETE_PROTECTION", Enabled: &enabled},
+			},
+		},
+	}
+
+	list, diags := flattenCapabilitySettings(ctx, api, prior)
+	if diags.HasError() {
+		t.Fatalf("flattenCapabilitySettings() diagnostics: %v", diags)
+	}
+	if !list.Equal(prior) {
+		t.Errorf("Expected settings %s, got %s", prior, list)
+	}
+
+	// Without configured settings, nothing is written to state
+	list, diags = flattenCapabilitySettings(ctx, api, types.ListNull(types.ObjectType{AttrTypes: capabilitySettingAttrTypes}))
+	if diags.HasError() {
+		t.Fatalf("flattenCapabilitySettings() diagnostics: %v", diags)
+	}
+	if !list.IsNull() {
+		t.Errorf("Expected null settings, got %s", list)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// BundleIDCapability represents a capability enabled on a Bundle ID in the App Store Connect API.
+type BundleIDCapability struct {
+	Type       string                       `json:"type"`
+	ID         string                       `json:"id"`
+	Attributes BundleIDCapabilityAttributes `json:"attributes"`
+	Links      ResourceLinks                `json:"links,omitempty"`
+}
+
+// BundleIDCapabilityAttributes represents the attributes of a Bundle ID capability.
+type BundleIDCapabilityAttributes struct {
+	CapabilityType string              `json:"capabilityType"`
+	Settings       []CapabilitySetting `json:"settings,omitempty"`
+}
+
+// CapabilitySetting represents a setting of a Bundle ID capability.
+type CapabilitySetting struct {
+	Key     string             `json:"key"`
+	Options []CapabilityOption `json:"options,omitempty"`
+}
+
+// CapabilityOption represents an option of a capability setting.
+type CapabilityOption struct {
+	Key     string `json:"key"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+// BundleIDCapabilityCreateRequest represents the request body for enabling a capability.
+type BundleIDCapabilityCreateRequest struct {
+	Data BundleIDCapabilityCreateRequestData `json:"data"`
+}
+
+// BundleIDCapabilityCreateRequestData represents the data for enabling a capability.
+type BundleIDCapabilityCreateRequestData struct {
+	Type          string                                       `json:"type"`
+	Attributes    BundleIDCapabilityAttributes                 `json:"attributes"`
+	Relationships BundleIDCapabilityCreateRequestRelationships `json:"relationships"`
+}
+
+// BundleIDCapabilityCreateRequestRelationships represents the relationships for enabling a capability.
+type BundleIDCapabilityCreateRequestRelationships struct {
+	BundleID BundleIDCapabilityCreateRequestRelationship `json:"bundleId"`
+}
+
+// BundleIDCapabilityCreateRequestRelationship represents a relationship in the create request.
+type BundleIDCapabilityCreateRequestRelationship struct {
+	Data RelationshipData `json:"data"`
+}
+
+// BundleIDCapabilityUpdateRequest represents the request body for updating a capability.
+type BundleIDCapabilityUpdateRequest struct {
+	Data BundleIDCapabilityUpdateRequestData `json:"data"`
+}
+
+// BundleIDCapabilityUpdateRequestData represents the data for updating a capability.
+type BundleIDCapabilityUpdateRequestData struct {
+	Type       string                       `json:"type"`
+	ID         string                       `json:"id"`
+	Attributes BundleIDCapabilityAttributes `json:"attributes"`
+}
+
+// Capability types.
+const (
+	CapabilityTypeICloud                         = "ICLOUD"
+	CapabilityTypeInAppPurchase                  = "IN_APP_PURCHASE"
+	CapabilityTypeGameCenter                     = "GAME_CENTER"
+	CapabilityTypePushNotifications              = "PUSH_NOTIFICATIONS"
+	CapabilityTypeWallet                         = "WALLET"
+	CapabilityTypeInterAppAudio                  = "INTER_APP_AUDIO"
+	CapabilityTypeMaps                           = "MAPS"
+	CapabilityTypeAssociatedDomains              = "ASSOCIATED_DOMAINS"
+	CapabilityTypePersonalVPN                    = "PERSONAL_VPN"
+	CapabilityTypeAppGroups                      = "APP_GROUPS"
+	CapabilityTypeHealthKit                      = "HEALTHKIT"
+	CapabilityTypeHomeKit                        = "HOMEKIT"
+	CapabilityTypeWirelessAccessoryConfiguration = "WIRELESS_ACCESSORY_CONFIGURATION"
+	CapabilityTypeApplePay                       = "APPLE_PAY"
+	CapabilityTypeDataProtection                 = "DATA_PROTECTION"
+	CapabilityTypeSiriKit                        = "SIRIKIT"
+	CapabilityTypeNetworkExtensions              = "NETWORK_EXTENSIONS"
+	CapabilityTypeMultipath                      = "MULTIPATH"
+	CapabilityTypeHotSpot                        = "HOT_SPOT"
+	CapabilityTypeNFCTagReading                  = "NFC_TAG_READING"
+	CapabilityTypeClassKit                       = "CLASSKIT"
+	CapabilityTypeAutoFillCredentialProvider     = "AUTOFILL_CREDENTIAL_PROVIDER"
+	CapabilityTypeAccessWiFiInformation          = "ACCESS_WIFI_INFORMATION"
+	CapabilityTypeNetworkCustomProtocol          = "NETWORK_CUSTOM_PROTOCOL"
+	CapabilityTypeCoreMediaHLSLowLatency         = "COREMEDIA_HLS_LOW_LATENCY"
+	CapabilityTypeSystemExtensionInstall         = "SYSTEM_EXTENSION_INSTALL"
+	CapabilityTypeUserManagement                 = "USER_MANAGEMENT"
+	CapabilityTypeAppleIDAuth                    = "APPLE_ID_AUTH"
+)
+
+// capabilityTypes lists every capability type accepted by the API.
+var capabilityTypes = []string{
+	CapabilityTypeICloud,
+	CapabilityTypeInAppPurchase,
+	CapabilityTypeGameCenter,
+	CapabilityTypePushNotifications,
+	CapabilityTypeWallet,
+	CapabilityTypeInterAppAudio,
+	CapabilityTypeMaps,
+	CapabilityTypeAssociatedDomains,
+	CapabilityTypePersonalVPN,
+	CapabilityTypeAppGroups,
+	CapabilityTypeHealthKit,
+	CapabilityTypeHomeKit,
+	CapabilityTypeWirelessAccessoryConfiguration,
+	CapabilityTypeApplePay,
+	CapabilityTypeDataProtection,
+	CapabilityTypeSiriKit,
+	CapabilityTypeNetworkExtensions,
+	CapabilityTypeMultipath,
+	CapabilityTypeHotSpot,
+	CapabilityTypeNFCTagReading,
+	CapabilityTypeClassKit,
+	CapabilityTypeAutoFillCredentialProvider,
+	CapabilityTypeAccessWiFiInformation,
+	CapabilityTypeNetworkCustomProtocol,
+	CapabilityTypeCoreMediaHLSLowLatency,
+	CapabilityTypeSystemExtensionInstall,
+	CapabilityTypeUserManagement,
+	CapabilityTypeAppleIDAuth,
+}
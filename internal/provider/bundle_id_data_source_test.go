@@ -0,0 +1,87 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBundleIDDataSource(t *testing.T) {
+	identifier := fmt.Sprintf("io.truetickets.test.datasource%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing using ID
+			{
+				Config: testAccBundleIDDataSourceConfigByID(identifier),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.appleappstoreconnect_bundle_id.test", "id"),
+					resource.TestCheckResourceAttr("data.appleappstoreconnect_bundle_id.test", "identifier", identifier),
+					resource.TestCheckResourceAttr("data.appleappstoreconnect_bundle_id.test", "name", "Test Bundle ID"),
+				),
+			},
+			// Read testing using filter
+			{
+				Config: testAccBundleIDDataSourceConfigByFilter(identifier),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.appleappstoreconnect_bundle_id.test", "id"),
+					resource.TestCheckResourceAttr("data.appleappstoreconnect_bundle_id.test", "identifier", identifier),
+					resource.TestCheckResourceAttr("data.appleappstoreconnect_bundle_id.test", "platform", "IOS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBundleIDDataSourceConfigByID(identifier string) string {
+	return fmt.Sprintf(`
+resource "appleappstoreconnect_bundle_id" "test" {
+  identifier = %[1]q
+  name       = "Test Bundle ID"
+  platform   = "IOS"
+}
+
+data "appleappstoreconnect_bundle_id" "test" {
+  id = appleappstoreconnect_bundle_id.test.id
+}
+`, identifier)
+}
+
+func testAccBundleIDDataSourceConfigByFilter(identifier string) string {
+	return fmt.Sprintf(`
+resource "appleappstoreconnect_bundle_id" "test" {
+  identifier = %[1]q
+  name       = "Test Bundle ID"
+  platform   = "IOS"
+}
+
+data "appleappstoreconnect_bundle_id" "test" {
+  filter = {
+    identifier = appleappstoreconnect_bundle_id.test.identifier
+    platform   = "IOS"
+  }
+}
+`, identifier)
+}
+
+func TestBundleIDDataSourceUpdateModel_SeedID(t *testing.T) {
+	d := &BundleIDDataSource{}
+
+	var model BundleIDDataSourceModel
+	d.updateModel(&model, &BundleID{ID: "BUNDLE1", Attributes: BundleIDAttributes{Identifier: "io.truetickets.app"}})
+	if model.SeedID.IsNull() || model.SeedID.ValueString() != "" {
+		t.Errorf("seed_id = %s, want an empty string when the API omits it", model.SeedID)
+	}
+
+	d.updateModel(&model, &BundleID{ID: "BUNDLE1", Attributes: BundleIDAttributes{SeedID: "ABCDE12345"}})
+	if got := model.SeedID.ValueString(); got != "ABCDE12345" {
+		t.Errorf("seed_id = %q, want %q", got, "ABCDE12345")
+	}
+}
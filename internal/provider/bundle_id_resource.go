@@ -0,0 +1,365 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BundleIDResource{}
+var _ resource.ResourceWithImportState = &BundleIDResource{}
+
+// bundleIdentifierPattern matches reverse-DNS bundle identifiers, optionally ending in a wildcard.
+var bundleIdentifierPattern = regexp.MustCompile(`^([A-Za-z0-9-]+\.)*([A-Za-z0-9-]+|\*)$`)
+
+// bundleIDErrorPointers maps JSON:API error source pointers to resource attributes.
+var bundleIDErrorPointers = map[string]path.Path{
+	"/data/attributes/identifier": path.Root("identifier"),
+	"/data/attributes/name":       path.Root("name"),
+	"/data/attributes/platform":   path.Root("platform"),
+	"/data/attributes/seedId":     path.Root("seed_id"),
+}
+
+// NewBundleIDResource creates a new Bundle ID resource.
+func NewBundleIDResource() resource.Resource {
+	return &BundleIDResource{}
+}
+
+// BundleIDResource defines the resource implementation.
+type BundleIDResource struct {
+	client *Client
+}
+
+// BundleIDResourceModel describes the resource data model.
+type BundleIDResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Identifier types.String `tfsdk:"identifier"`
+	Name       types.String `tfsdk:"name"`
+	Platform   types.String `tfsdk:"platform"`
+	SeedID     types.String `tfsdk:"seed_id"`
+}
+
+func (r *BundleIDResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle_id"
+}
+
+func (r *BundleIDResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Bundle ID (App ID) in App Store Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the Bundle ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "The bundle identifier (e.g., 'io.truetickets.app'). Use a trailing '*' for a wildcard App ID (e.g., 'io.truetickets.*').",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						bundleIdentifierPattern,
+						"must be a reverse-DNS identifier, optionally ending in '*'",
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Bundle ID. This can be updated in place.",
+				Required:            true,
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "The platform of the Bundle ID. Valid values are: `IOS`, `MAC_OS`, `UNIVERSAL`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						BundleIDPlatformIOS,
+						BundleIDPlatformMacOS,
+						BundleIDPlatformUniversal,
+					),
+				},
+			},
+			"seed_id": schema.StringAttribute{
+				MarkdownDescription: "The seed ID (App ID prefix) of the Bundle ID. Defaults to the team ID when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BundleIDResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BundleIDResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BundleIDResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the request
+	createReq := BundleIDCreateRequest{
+		Data: BundleIDCreateRequestData{
+			Type: "bundleIds",
+			Attributes: BundleIDCreateRequestAttributes{
+				Identifier: data.Identifier.ValueString(),
+				Name:       data.Name.ValueString(),
+				Platform:   data.Platform.ValueString(),
+			},
+		},
+	}
+
+	if !data.SeedID.IsNull() && !data.SeedID.IsUnknown() {
+		createReq.Data.Attributes.SeedID = data.SeedID.ValueString()
+	}
+
+	tflog.Debug(ctx, "Creating Bundle ID", map[string]interface{}{
+		"identifier": data.Identifier.ValueString(),
+		"name":       data.Name.ValueString(),
+		"platform":   data.Platform.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodPost,
+		Endpoint: "/bundleIds",
+		Body:     createReq,
+	})
+	if err != nil {
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to create Bundle ID", err, bundleIDErrorPointers)
+		return
+	}
+
+	// Parse the response
+	var bundleID BundleID
+	if err := json.Unmarshal(apiResp.Data, &bundleID); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse Bundle ID response, got error: %s", err),
+		)
+		return
+	}
+
+	// Validate that we got an ID from the API
+	if bundleID.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid API Response",
+			"The API response did not contain a valid ID for the created Bundle ID",
+		)
+		return
+	}
+
+	updateBundleIDModel(&data, &bundleID)
+
+	tflog.Trace(ctx, "Created Bundle ID", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BundleIDResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BundleIDResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Bundle ID", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/bundleIds/%s", data.ID.ValueString()),
+	})
+	if err != nil {
+		if removeResourceIfNotFound(ctx, resp, err, "Bundle ID", data.ID.ValueString()) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Bundle ID, got error: %s", err),
+		)
+		return
+	}
+
+	// Parse the response
+	var bundleID BundleID
+	if err := json.Unmarshal(apiResp.Data, &bundleID); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse Bundle ID response, got error: %s", err),
+		)
+		return
+	}
+
+	updateBundleIDModel(&data, &bundleID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BundleIDResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BundleIDResourceModel
+	var state BundleIDResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the name can be changed in place; everything else requires replacement
+	updateReq := BundleIDUpdateRequest{
+		Data: BundleIDUpdateRequestData{
+			Type: "bundleIds",
+			ID:   state.ID.ValueString(),
+			Attributes: BundleIDUpdateRequestAttributes{
+				Name: plan.Name.ValueString(),
+			},
+		},
+	}
+
+	tflog.Debug(ctx, "Updating Bundle ID", map[string]interface{}{
+		"id":   state.ID.ValueString(),
+		"name": plan.Name.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodPatch,
+		Endpoint: fmt.Sprintf("/bundleIds/%s", state.ID.ValueString()),
+		Body:     updateReq,
+	})
+	if err != nil {
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to update Bundle ID", err, bundleIDErrorPointers)
+		return
+	}
+
+	// Parse the response
+	var bundleID BundleID
+	if err := json.Unmarshal(apiResp.Data, &bundleID); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse Bundle ID response, got error: %s", err),
+		)
+		return
+	}
+
+	updateBundleIDModel(&plan, &bundleID)
+
+	tflog.Trace(ctx, "Updated Bundle ID", map[string]interface{}{
+		"id": plan.ID.ValueString(),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BundleIDResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BundleIDResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Bundle ID", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Make the API request
+	_, err := r.client.Do(ctx, Request{
+		Method:   http.MethodDelete,
+		Endpoint: fmt.Sprintf("/bundleIds/%s", data.ID.ValueString()),
+	})
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete Bundle ID, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Bundle ID", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *BundleIDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateBundleIDModel updates the resource model with the Bundle ID data.
+func updateBundleIDModel(model *BundleIDResourceModel, bundleID *BundleID) {
+	model.ID = types.StringValue(bundleID.ID)
+	model.Identifier = types.StringValue(bundleID.Attributes.Identifier)
+	model.Name = types.StringValue(bundleID.Attributes.Name)
+	model.Platform = types.StringValue(bundleID.Attributes.Platform)
+	// An empty seed ID is kept as an empty string: storing null would make the next
+	// plan mark the Optional+Computed attribute unknown and force a replacement
+	model.SeedID = types.StringValue(bundleID.Attributes.SeedID)
+}
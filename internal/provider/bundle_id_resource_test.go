@@ -0,0 +1,104 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccBundleIDResource(t *testing.T) {
+	identifier := fmt.Sprintf("io.truetickets.test.bundle%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBundleIDResourceConfig(identifier, "Test Bundle ID"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id.test", "identifier", identifier),
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id.test", "name", "Test Bundle ID"),
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id.test", "platform", "IOS"),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_bundle_id.test", "id"),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_bundle_id.test", "seed_id"),
+				),
+			},
+			// Re-planning the same configuration must not propose changes, in particular
+			// no replacement caused by seed_id
+			{
+				Config: testAccBundleIDResourceConfig(identifier, "Test Bundle ID"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "appleappstoreconnect_bundle_id.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update name in place
+			{
+				Config: testAccBundleIDResourceConfig(identifier, "Renamed Bundle ID"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appleappstoreconnect_bundle_id.test", "name", "Renamed Bundle ID"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateBundleIDModel_EmptySeedID(t *testing.T) {
+	var model BundleIDResourceModel
+	updateBundleIDModel(&model, &BundleID{ID: "BUNDLE1", Attributes: BundleIDAttributes{Identifier: "io.truetickets.app"}})
+
+	// A null seed_id would become unknown in the next plan and force a replacement
+	if model.SeedID.IsNull() || model.SeedID.IsUnknown() || model.SeedID.ValueString() != "" {
+		t.Errorf("seed_id = %s, want a known empty string", model.SeedID)
+	}
+}
+
+func testAccBundleIDResourceConfig(identifier, name string) string {
+	return fmt.Sprintf(`
+resource "appleappstoreconnect_bundle_id" "test" {
+  identifier = %[1]q
+  name       = %[2]q
+  platform   = "IOS"
+}
+`, identifier, name)
+}
+
+func TestBundleIdentifierPattern(t *testing.T) {
+	tests := []struct {
+		identifier string
+		want       bool
+	}{
+		{identifier: "io.truetickets.app", want: true},
+		{identifier: "io.truetickets.my-app", want: true},
+		{identifier: "io.truetickets.*", want: true},
+		{identifier: "*", want: true},
+		{identifier: "app", want: true},
+		{identifier: "", want: false},
+		{identifier: "io.truetickets.", want: false},
+		{identifier: "io..truetickets", want: false},
+		{identifier: "io.*.app", want: false},
+		{identifier: "io.truetickets.app!", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			if got := bundleIdentifierPattern.MatchString(tt.identifier); got != tt.want {
+				t.Errorf("bundleIdentifierPattern.MatchString(%q) = %v, want %v", tt.identifier, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &CertificateBundleEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CertificateBundleEphemeralResource{}
+
+// NewCertificateBundleEphemeralResource creates a new Certificate Bundle ephemeral resource.
+func NewCertificateBundleEphemeralResource() ephemeral.EphemeralResource {
+	return &CertificateBundleEphemeralResource{}
+}
+
+// CertificateBundleEphemeralResource defines the ephemeral resource implementation.
+type CertificateBundleEphemeralResource struct {
+	client *Client
+}
+
+// CertificateBundleEphemeralResourceModel describes the ephemeral resource data model.
+type CertificateBundleEphemeralResourceModel struct {
+	CertificateID         types.String `tfsdk:"certificate_id"`
+	PrivateKeyPEM         types.String `tfsdk:"private_key_pem"`
+	PKCS12BundlePassword  types.String `tfsdk:"pkcs12_bundle_password"`
+	PKCS12Encoding        types.String `tfsdk:"pkcs12_encoding"`
+	PKCS12IncludeChain    types.Bool   `tfsdk:"pkcs12_include_chain"`
+	CertificateContentPEM types.String `tfsdk:"certificate_content_pem"`
+	CertificateChainPEM   types.String `tfsdk:"certificate_chain_pem"`
+	PKCS12BundleContent   types.String `tfsdk:"pkcs12_bundle_content"`
+	SerialNumber          types.String `tfsdk:"serial_number"`
+	ExpirationDate        types.String `tfsdk:"expiration_date"`
+}
+
+func (e *CertificateBundleEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_bundle"
+}
+
+func (e *CertificateBundleEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Builds a PKCS12 bundle for an existing certificate without storing it in Terraform state or plan. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"certificate_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the certificate in App Store Connect.",
+				Required:            true,
+			},
+			"private_key_pem": schema.StringAttribute{
+				MarkdownDescription: "The private key in PEM format that was used to create the certificate signing request. This is not sent to Apple's API.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"pkcs12_bundle_password": schema.StringAttribute{
+				MarkdownDescription: "Password to use for the PKCS12 bundle.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"pkcs12_encoding": schema.StringAttribute{
+				MarkdownDescription: "The encryption used for the PKCS12 bundle. Valid values are: `modern` (AES-256 and SHA-256), `legacy_des` (3DES) and `legacy_rc2` (40-bit RC2 and 3DES). Default is `modern`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						PKCS12EncodingModern,
+						PKCS12EncodingLegacyDES,
+						PKCS12EncodingLegacyRC2,
+					),
+				},
+			},
+			"pkcs12_include_chain": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include the certificate chain from `certificate_chain_pem` in the PKCS12 bundle. Default is `false`.",
+				Optional:            true,
+			},
+			"certificate_content_pem": schema.StringAttribute{
+				MarkdownDescription: "The certificate content in PEM format, base64 encoded.",
+				Computed:            true,
+			},
+			"certificate_chain_pem": schema.StringAttribute{
+				MarkdownDescription: "The issuing chain of the certificate in base64 encoded PEM format, built offline from the Apple CA certificates embedded in the provider. Null when no issuing certificate is found.",
+				Computed:            true,
+			},
+			"pkcs12_bundle_content": schema.StringAttribute{
+				MarkdownDescription: "The PKCS12 bundle content in base64 encoded format.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "The serial number of the certificate.",
+				Computed:            true,
+			},
+			"expiration_date": schema.StringAttribute{
+				MarkdownDescription: "The expiration date of the certificate.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *CertificateBundleEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *CertificateBundleEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data CertificateBundleEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Opening Certificate Bundle", map[string]interface{}{
+		"certificate_id": data.CertificateID.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := e.client.Do(ctx, Request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/certificates/%s", data.CertificateID.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read Certificate, got error: %s", err),
+		)
+		return
+	}
+
+	// Parse the response
+	var cert Certificate
+	if err := json.Unmarshal(apiResp.Data, &cert); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse Certificate response, got error: %s", err),
+		)
+		return
+	}
+
+	if cert.Attributes.CertificateContent == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_id"),
+			"Certificate Content Unavailable",
+			fmt.Sprintf("App Store Connect did not return content for Certificate %s.", cert.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(validatePrivateKey(path.Root("private_key_pem"), data.PrivateKeyPEM, types.StringNull(), types.StringValue(cert.Attributes.CertificateContent))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert DER to PEM format
+	pemContent, err := convertDERToPEM(cert.Attributes.CertificateContent)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Certificate Conversion Error",
+			fmt.Sprintf("Unable to convert certificate to PEM format: %s", err),
+		)
+		return
+	}
+
+	certPEMBytes, err := base64.StdEncoding.DecodeString(pemContent)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Certificate Conversion Error",
+			fmt.Sprintf("Unable to decode base64 certificate PEM: %s", err),
+		)
+		return
+	}
+
+	// Build the certificate chain from the embedded Apple CA certificates
+	chain, err := appleCertificateChain(cert.Attributes.CertificateContent)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Certificate Chain Error",
+			fmt.Sprintf("Unable to build certificate chain: %s", err),
+		)
+		return
+	}
+
+	var caCerts []*x509.Certificate
+	if data.PKCS12IncludeChain.ValueBool() {
+		if len(chain) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pkcs12_include_chain"),
+				"Certificate Chain Not Found",
+				fmt.Sprintf("No issuing certificate for Certificate %s was found among the Apple CA certificates embedded in the provider.", cert.ID),
+			)
+			return
+		}
+		caCerts = chain
+	}
+
+	pkcs12Content, err := generatePKCS12Bundle(
+		string(certPEMBytes),
+		data.PrivateKeyPEM.ValueString(),
+		data.PKCS12BundlePassword.ValueString(),
+		data.PKCS12Encoding.ValueString(),
+		caCerts,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"PKCS12 Bundle Generation Error",
+			fmt.Sprintf("Unable to generate PKCS12 bundle: %s", err),
+		)
+		return
+	}
+
+	data.CertificateContentPEM = types.StringValue(pemContent)
+	if len(chain) > 0 {
+		data.CertificateChainPEM = types.StringValue(encodeCertificateChainPEM(chain))
+	} else {
+		data.CertificateChainPEM = types.StringNull()
+	}
+	data.PKCS12BundleContent = types.StringValue(pkcs12Content)
+	data.SerialNumber = types.StringValue(cert.Attributes.SerialNumber)
+
+	if cert.Attributes.ExpirationDate != nil {
+		data.ExpirationDate = types.StringValue(cert.Attributes.ExpirationDate.Format("2006-01-02T15:04:05Z"))
+	} else {
+		data.ExpirationDate = types.StringNull()
+	}
+
+	// Save data into the ephemeral result; it is never persisted to state
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
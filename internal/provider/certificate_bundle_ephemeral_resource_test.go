@@ -0,0 +1,99 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestCertificateBundleEphemeralResourceOpen(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, keyPEM, priv := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/certificates/CERT1" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"data":{"type":"certificates","id":"CERT1","attributes":{"certificateType":"PASS_TYPE_ID","serialNumber":"2A","certificateContent":%q,"expirationDate":"2030-01-02T03:04:05.000Z"}}}`,
+			base64.StdEncoding.EncodeToString(certBlock.Bytes))
+	})
+
+	e := &CertificateBundleEphemeralResource{client: client}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"certificate_id":          tftypes.NewValue(tftypes.String, "CERT1"),
+			"private_key_pem":         tftypes.NewValue(tftypes.String, keyPEM),
+			"pkcs12_bundle_password":  tftypes.NewValue(tftypes.String, "secret"),
+			"pkcs12_encoding":         tftypes.NewValue(tftypes.String, nil),
+			"pkcs12_include_chain":    tftypes.NewValue(tftypes.Bool, nil),
+			"certificate_chain_pem":   tftypes.NewValue(tftypes.String, nil),
+			"certificate_content_pem": tftypes.NewValue(tftypes.String, nil),
+			"pkcs12_bundle_content":   tftypes.NewValue(tftypes.String, nil),
+			"serial_number":           tftypes.NewValue(tftypes.String, nil),
+			"expiration_date":         tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	e.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	var got CertificateBundleEphemeralResourceModel
+	resp.Diagnostics.Append(resp.Result.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to get result: %v", resp.Diagnostics)
+	}
+
+	if got.SerialNumber != types.StringValue("2A") {
+		t.Errorf("serial_number = %s, want %q", got.SerialNumber, "2A")
+	}
+	if got.ExpirationDate.ValueString() != "2030-01-02T03:04:05Z" {
+		t.Errorf("expiration_date = %q, want %q", got.ExpirationDate.ValueString(), "2030-01-02T03:04:05Z")
+	}
+
+	p12, err := base64.StdEncoding.DecodeString(got.PKCS12BundleContent.ValueString())
+	if err != nil {
+		t.Fatalf("pkcs12_bundle_content is not valid base64: %v", err)
+	}
+	key, cert, err := pkcs12.Decode(p12, "secret")
+	if err != nil {
+		t.Fatalf("Failed to decode PKCS12 bundle: %v", err)
+	}
+	if !priv.Equal(key) {
+		t.Error("PKCS12 bundle does not contain the configured private key")
+	}
+	if cert.SerialNumber.Cmp(big.NewInt(42)) != 0 {
+		t.Errorf("PKCS12 certificate serial = %s, want 42", cert.SerialNumber)
+	}
+}
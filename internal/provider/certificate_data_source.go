@@ -48,6 +48,8 @@ type CertificateDataSourceModel struct {
 	Relationships         types.Object `tfsdk:"relationships"`
 	// Filter attributes
 	Filter types.Object `tfsdk:"filter"`
+
+	CertificateDetailsModel
 }
 
 // CertificateFilterModel describes the filter criteria.
@@ -157,6 +159,11 @@ func (d *CertificateDataSource) Schema(ctx context.Context, req datasource.Schem
 			},
 		},
 	}
+
+	// Add the X.509 details parsed from the certificate content
+	for name, attribute := range certificateDetailsDataSourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (d *CertificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -211,9 +218,9 @@ func (d *CertificateDataSource) Read(ctx context.Context, req datasource.ReadReq
 			return
 		}
 
-		// Parse the response
-		var certResp CertificateResponse
-		if err := json.Unmarshal(apiResp.Data, &certResp); err != nil {
+		// Parse the response - apiResp.Data contains just the object from the "data" field
+		var cert Certificate
+		if err := json.Unmarshal(apiResp.Data, &cert); err != nil {
 			resp.Diagnostics.AddError(
 				"Parse Error",
 				fmt.Sprintf("Unable to parse Certificate response, got error: %s", err),
@@ -222,7 +229,7 @@ func (d *CertificateDataSource) Read(ctx context.Context, req datasource.ReadReq
 		}
 
 		// Update the model with the response data
-		d.updateModel(&data, &certResp.Data, resp)
+		d.updateModel(&data, &cert, resp)
 
 	} else if !data.Filter.IsNull() {
 		// Extract filter criteria
@@ -245,9 +252,8 @@ func (d *CertificateDataSource) Read(ctx context.Context, req datasource.ReadReq
 			"serial_number":    filter.SerialNumber.ValueString(),
 		})
 
-		// Make the API request to list certificates
-		apiResp, err := d.client.Do(ctx, Request{
-			Method:   http.MethodGet,
+		// Make the API request to list certificates, following every page of results
+		apiResp, err := d.client.DoAll(ctx, Request{
 			Endpoint: "/certificates",
 			Query:    query,
 		})
@@ -259,9 +265,9 @@ func (d *CertificateDataSource) Read(ctx context.Context, req datasource.ReadReq
 			return
 		}
 
-		// Parse the response
-		var certsResp CertificatesResponse
-		if err := json.Unmarshal(apiResp.Data, &certsResp); err != nil {
+		// Parse the response - apiResp.Data contains the merged array from every page
+		var certificates []Certificate
+		if err := json.Unmarshal(apiResp.Data, &certificates); err != nil {
 			resp.Diagnostics.AddError(
 				"Parse Error",
 				fmt.Sprintf("Unable to parse Certificates response, got error: %s", err),
@@ -273,13 +279,13 @@ func (d *CertificateDataSource) Read(ctx context.Context, req datasource.ReadReq
 		var matchingCerts []Certificate
 		if !filter.SerialNumber.IsNull() {
 			serialNumber := filter.SerialNumber.ValueString()
-			for _, cert := range certsResp.Data {
+			for _, cert := range certificates {
 				if cert.Attributes.SerialNumber == serialNumber {
 					matchingCerts = append(matchingCerts, cert)
 				}
 			}
 		} else {
-			matchingCerts = certsResp.Data
+			matchingCerts = certificates
 		}
 
 		// Check if we found exactly one result
@@ -359,6 +365,17 @@ func (d *CertificateDataSource) updateModel(model *CertificateDataSourceModel, c
 		model.CertificateCAIssuers = types.ListNull(types.StringType)
 	}
 
+	// Parse the X.509 details of the certificate
+	details, err := parseCertificateDetails(cert.Attributes.CertificateContent)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Certificate Parsing Error",
+			fmt.Sprintf("Unable to parse certificate details: %s", err),
+		)
+		return
+	}
+	model.CertificateDetailsModel = details
+
 	if cert.Attributes.ExpirationDate != nil {
 		model.ExpirationDate = types.StringValue(cert.Attributes.ExpirationDate.Format("2006-01-02T15:04:05Z"))
 	}
@@ -4,10 +4,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -89,3 +98,74 @@ data "appleappstoreconnect_certificate" "test" {
 }
 `, timestamp)
 }
+
+// TestCertificateDataSourceRead_ByID checks that the by-ID lookup reads the certificate
+// from the response. The client already unwraps the "data" field, so decoding it as a
+// CertificateResponse again left every attribute empty.
+func TestCertificateDataSourceRead_ByID(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/certificates/CERT1" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"data":{"type":"certificates","id":"CERT1","attributes":{"certificateType":"PASS_TYPE_ID","serialNumber":"2A","certificateContent":%q}}}`,
+			base64.StdEncoding.EncodeToString(certBlock.Bytes))
+	})
+
+	d := &CertificateDataSource{client: client}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	// tfsdk.Config cannot be set directly, so build the raw value through a State
+	configState := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := configState.Set(ctx, &CertificateDataSourceModel{
+		ID:                      types.StringValue("CERT1"),
+		CertificateCAIssuers:    types.ListNull(types.StringType),
+		Relationships:           types.ObjectNull(map[string]attr.Type{"pass_type_id": types.StringType}),
+		Filter:                  types.ObjectNull(map[string]attr.Type{"certificate_type": types.StringType, "serial_number": types.StringType}),
+		CertificateDetailsModel: nullCertificateDetails(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to set config: %v", diags)
+	}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	var got CertificateDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to get state: %v", resp.Diagnostics)
+	}
+
+	if got.SerialNumber.ValueString() != "2A" {
+		t.Errorf("serial_number = %q, want %q", got.SerialNumber.ValueString(), "2A")
+	}
+	if got.SubjectDN.ValueString() != "CN=Pass Type ID: pass.io.truetickets.test" {
+		t.Errorf("subject_dn = %q, want the certificate subject", got.SubjectDN.ValueString())
+	}
+	if got.KeySize.ValueInt64() != 2048 {
+		t.Errorf("key_size = %d, want 2048", got.KeySize.ValueInt64())
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CertificateDetailsModel describes the X.509 details parsed from the certificate content.
+// It is embedded in the certificate resource and data source models.
+type CertificateDetailsModel struct {
+	SubjectDN         types.String `tfsdk:"subject_dn"`
+	IssuerDN          types.String `tfsdk:"issuer_dn"`
+	SHA1Fingerprint   types.String `tfsdk:"sha1_fingerprint"`
+	SHA256Fingerprint types.String `tfsdk:"sha256_fingerprint"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	KeyAlgorithm      types.String `tfsdk:"key_algorithm"`
+	KeySize           types.Int64  `tfsdk:"key_size"`
+	PublicKeyPEM      types.String `tfsdk:"public_key_pem"`
+	ExtendedKeyUsages types.List   `tfsdk:"extended_key_usages"`
+}
+
+// certificateDetailsAttrTypes are the attribute types of CertificateDetailsModel.
+var certificateDetailsAttrTypes = map[string]attr.Type{
+	"subject_dn":          types.StringType,
+	"issuer_dn":           types.StringType,
+	"sha1_fingerprint":    types.StringType,
+	"sha256_fingerprint":  types.StringType,
+	"not_before":          types.StringType,
+	"key_algorithm":       types.StringType,
+	"key_size":            types.Int64Type,
+	"public_key_pem":      types.StringType,
+	"extended_key_usages": types.ListType{ElemType: types.StringType},
+}
+
+// extKeyUsageNames maps the extended key usages known to crypto/x509 to attribute values.
+var extKeyUsageNames = map[x509.ExtKeyUsage]string{
+	x509.ExtKeyUsageAny:                            "any",
+	x509.ExtKeyUsageServerAuth:                     "server_auth",
+	x509.ExtKeyUsageClientAuth:                     "client_auth",
+	x509.ExtKeyUsageCodeSigning:                    "code_signing",
+	x509.ExtKeyUsageEmailProtection:                "email_protection",
+	x509.ExtKeyUsageIPSECEndSystem:                 "ipsec_end_system",
+	x509.ExtKeyUsageIPSECTunnel:                    "ipsec_tunnel",
+	x509.ExtKeyUsageIPSECUser:                      "ipsec_user",
+	x509.ExtKeyUsageTimeStamping:                   "timestamping",
+	x509.ExtKeyUsageOCSPSigning:                    "ocsp_signing",
+	x509.ExtKeyUsageMicrosoftServerGatedCrypto:     "microsoft_server_gated_crypto",
+	x509.ExtKeyUsageNetscapeServerGatedCrypto:      "netscape_server_gated_crypto",
+	x509.ExtKeyUsageMicrosoftCommercialCodeSigning: "microsoft_commercial_code_signing",
+	x509.ExtKeyUsageMicrosoftKernelCodeSigning:     "microsoft_kernel_code_signing",
+}
+
+// nullCertificateDetails returns details with every attribute set to null.
+func nullCertificateDetails() CertificateDetailsModel {
+	return CertificateDetailsModel{
+		SubjectDN:         types.StringNull(),
+		IssuerDN:          types.StringNull(),
+		SHA1Fingerprint:   types.StringNull(),
+		SHA256Fingerprint: types.StringNull(),
+		NotBefore:         types.StringNull(),
+		KeyAlgorithm:      types.StringNull(),
+		KeySize:           types.Int64Null(),
+		PublicKeyPEM:      types.StringNull(),
+		ExtendedKeyUsages: types.ListNull(types.StringType),
+	}
+}
+
+// parseCertificateDetails parses a base64 encoded DER certificate into its X.509 details.
+// Empty content yields null details.
+func parseCertificateDetails(base64DER string) (CertificateDetailsModel, error) {
+	if base64DER == "" {
+		return nullCertificateDetails(), nil
+	}
+
+	// Decode the base64 encoded DER
+	derBytes, err := base64.StdEncoding.DecodeString(base64DER)
+	if err != nil {
+		return CertificateDetailsModel{}, fmt.Errorf("failed to decode base64 certificate: %w", err)
+	}
+
+	// Parse the X509 certificate
+	cert, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		return CertificateDetailsModel{}, fmt.Errorf("failed to parse X509 certificate: %w", err)
+	}
+
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return CertificateDetailsModel{}, fmt.Errorf("failed to marshal public key: %w", err)
+	}
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})
+
+	sha1Sum := sha1.Sum(cert.Raw)
+	sha256Sum := sha256.Sum256(cert.Raw)
+
+	keySize := types.Int64Null()
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		keySize = types.Int64Value(int64(pub.N.BitLen()))
+	case *ecdsa.PublicKey:
+		keySize = types.Int64Value(int64(pub.Curve.Params().BitSize))
+	case ed25519.PublicKey:
+		keySize = types.Int64Value(int64(len(pub) * 8))
+	}
+
+	// Apple-specific usages such as Pass Type ID signing are returned as OIDs
+	usages := make([]attr.Value, 0, len(cert.ExtKeyUsage)+len(cert.UnknownExtKeyUsage))
+	for _, usage := range cert.ExtKeyUsage {
+		if name, ok := extKeyUsageNames[usage]; ok {
+			usages = append(usages, types.StringValue(name))
+		}
+	}
+	for _, oid := range cert.UnknownExtKeyUsage {
+		usages = append(usages, types.StringValue(oid.String()))
+	}
+
+	usageList, diags := types.ListValue(types.StringType, usages)
+	if diags.HasError() {
+		return CertificateDetailsModel{}, fmt.Errorf("failed to build extended key usages: %v", diags)
+	}
+
+	return CertificateDetailsModel{
+		SubjectDN:         types.StringValue(cert.Subject.String()),
+		IssuerDN:          types.StringValue(cert.Issuer.String()),
+		SHA1Fingerprint:   types.StringValue(hex.EncodeToString(sha1Sum[:])),
+		SHA256Fingerprint: types.StringValue(hex.EncodeToString(sha256Sum[:])),
+		NotBefore:         types.StringValue(cert.NotBefore.UTC().Format("2006-01-02T15:04:05Z")),
+		KeyAlgorithm:      types.StringValue(cert.PublicKeyAlgorithm.String()),
+		KeySize:           keySize,
+		PublicKeyPEM:      types.StringValue(base64.StdEncoding.EncodeToString(publicKeyPEM)),
+		ExtendedKeyUsages: usageList,
+	}, nil
+}
+
+// certificateDetailsResourceAttributes returns the resource schema attributes for CertificateDetailsModel.
+func certificateDetailsResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"subject_dn": schema.StringAttribute{
+			MarkdownDescription: "The distinguished name of the certificate subject.",
+			Computed:            true,
+		},
+		"issuer_dn": schema.StringAttribute{
+			MarkdownDescription: "The distinguished name of the certificate issuer.",
+			Computed:            true,
+		},
+		"sha1_fingerprint": schema.StringAttribute{
+			MarkdownDescription: "The hex encoded SHA-1 fingerprint of the certificate.",
+			Computed:            true,
+		},
+		"sha256_fingerprint": schema.StringAttribute{
+			MarkdownDescription: "The hex encoded SHA-256 fingerprint of the certificate.",
+			Computed:            true,
+		},
+		"not_before": schema.StringAttribute{
+			MarkdownDescription: "The date from which the certificate is valid.",
+			Computed:            true,
+		},
+		"key_algorithm": schema.StringAttribute{
+			MarkdownDescription: "The public key algorithm of the certificate, such as `RSA` or `ECDSA`.",
+			Computed:            true,
+		},
+		"key_size": schema.Int64Attribute{
+			MarkdownDescription: "The size of the public key in bits.",
+			Computed:            true,
+		},
+		"public_key_pem": schema.StringAttribute{
+			MarkdownDescription: "The public key of the certificate in base64 encoded PEM format.",
+			Computed:            true,
+		},
+		"extended_key_usages": schema.ListAttribute{
+			MarkdownDescription: "The extended key usages of the certificate, such as `client_auth`. Usages without a well-known name, including Apple-specific ones, are returned as OIDs.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// certificateDetailsDataSourceAttributes returns the data source schema attributes for CertificateDetailsModel.
+func certificateDetailsDataSourceAttributes() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"subject_dn": dsschema.StringAttribute{
+			MarkdownDescription: "The distinguished name of the certificate subject.",
+			Computed:            true,
+		},
+		"issuer_dn": dsschema.StringAttribute{
+			MarkdownDescription: "The distinguished name of the certificate issuer.",
+			Computed:            true,
+		},
+		"sha1_fingerprint": dsschema.StringAttribute{
+			MarkdownDescription: "The hex encoded SHA-1 fingerprint of the certificate.",
+			Computed:            true,
+		},
+		"sha256_fingerprint": dsschema.StringAttribute{
+			MarkdownDescription: "The hex encoded SHA-256 fingerprint of the certificate.",
+			Computed:            true,
+		},
+		"not_before": dsschema.StringAttribute{
+			MarkdownDescription: "The date from which the certificate is valid.",
+			Computed:            true,
+		},
+		"key_algorithm": dsschema.StringAttribute{
+			MarkdownDescription: "The public key algorithm of the certificate, such as `RSA` or `ECDSA`.",
+			Computed:            true,
+		},
+		"key_size": dsschema.Int64Attribute{
+			MarkdownDescription: "The size of the public key in bits.",
+			Computed:            true,
+		},
+		"public_key_pem": dsschema.StringAttribute{
+			MarkdownDescription: "The public key of the certificate in base64 encoded PEM format.",
+			Computed:            true,
+		},
+		"extended_key_usages": dsschema.ListAttribute{
+			MarkdownDescription: "The extended key usages of the certificate, such as `client_auth`. Usages without a well-known name, including Apple-specific ones, are returned as OIDs.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestParseCertificateDetails(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate ECDSA key: %v", err)
+	}
+
+	passTypeIDUsage := asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 4, 14}
+	notBefore := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		key           crypto.Signer
+		wantAlgorithm string
+		wantKeySize   int64
+	}{
+		{
+			name:          "rsa",
+			key:           rsaKey,
+			wantAlgorithm: "RSA",
+			wantKeySize:   2048,
+		},
+		{
+			name:          "ecdsa",
+			key:           ecKey,
+			wantAlgorithm: "ECDSA",
+			wantKeySize:   256,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := &x509.Certificate{
+				SerialNumber:       big.NewInt(1),
+				Subject:            pkix.Name{CommonName: "Pass Type ID: pass.io.truetickets.test", Organization: []string{"TrueTickets"}},
+				NotBefore:          notBefore,
+				NotAfter:           notBefore.Add(365 * 24 * time.Hour),
+				ExtKeyUsage:        []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+				UnknownExtKeyUsage: []asn1.ObjectIdentifier{passTypeIDUsage},
+			}
+
+			der, err := x509.CreateCertificate(rand.Reader, template, template, tt.key.Public(), tt.key)
+			if err != nil {
+				t.Fatalf("Failed to create certificate: %v", err)
+			}
+
+			details, err := parseCertificateDetails(base64.StdEncoding.EncodeToString(der))
+			if err != nil {
+				t.Fatalf("parseCertificateDetails() error = %v", err)
+			}
+
+			wantDN := "CN=Pass Type ID: pass.io.truetickets.test,O=TrueTickets"
+			if got := details.SubjectDN.ValueString(); got != wantDN {
+				t.Errorf("subject_dn = %q, want %q", got, wantDN)
+			}
+			if got := details.IssuerDN.ValueString(); got != wantDN {
+				t.Errorf("issuer_dn = %q, want %q", got, wantDN)
+			}
+
+			sum := sha256.Sum256(der)
+			if got := details.SHA256Fingerprint.ValueString(); got != hex.EncodeToString(sum[:]) {
+				t.Errorf("sha256_fingerprint = %q, want %q", got, hex.EncodeToString(sum[:]))
+			}
+			if got := len(details.SHA1Fingerprint.ValueString()); got != 40 {
+				t.Errorf("len(sha1_fingerprint) = %d, want 40", got)
+			}
+
+			if got := details.NotBefore.ValueString(); got != "2025-01-02T03:04:05Z" {
+				t.Errorf("not_before = %q, want %q", got, "2025-01-02T03:04:05Z")
+			}
+			if got := details.KeyAlgorithm.ValueString(); got != tt.wantAlgorithm {
+				t.Errorf("key_algorithm = %q, want %q", got, tt.wantAlgorithm)
+			}
+			if got := details.KeySize.ValueInt64(); got != tt.wantKeySize {
+				t.Errorf("key_size = %d, want %d", got, tt.wantKeySize)
+			}
+
+			publicKeyPEM, err := base64.StdEncoding.DecodeString(details.PublicKeyPEM.ValueString())
+			if err != nil {
+				t.Fatalf("public_key_pem is not valid base64: %v", err)
+			}
+			block, _ := pem.Decode(publicKeyPEM)
+			if block == nil || block.Type != "PUBLIC KEY" {
+				t.Fatalf("public_key_pem does not contain a PUBLIC KEY block")
+			}
+			if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				t.Errorf("Failed to parse public key: %v", err)
+			}
+
+			var usages []string
+			if diags := details.ExtendedKeyUsages.ElementsAs(context.Background(), &usages, false); diags.HasError() {
+				t.Fatalf("Failed to read extended_key_usages: %v", diags)
+			}
+			if len(usages) != 2 || usages[0] != "client_auth" || usages[1] != passTypeIDUsage.String() {
+				t.Errorf("extended_key_usages = %v, want [client_auth %s]", usages, passTypeIDUsage)
+			}
+		})
+	}
+}
+
+func TestParseCertificateDetails_Empty(t *testing.T) {
+	details, err := parseCertificateDetails("")
+	if err != nil {
+		t.Fatalf("parseCertificateDetails() error = %v", err)
+	}
+
+	if !details.SubjectDN.IsNull() || !details.KeySize.IsNull() || !details.ExtendedKeyUsages.IsNull() {
+		t.Error("Expected null details for empty certificate content")
+	}
+}
+
+func TestParseCertificateDetails_Invalid(t *testing.T) {
+	if _, err := parseCertificateDetails("not-valid-base64!"); err == nil {
+		t.Error("Expected error for invalid base64, got nil")
+	}
+
+	if _, err := parseCertificateDetails(base64.StdEncoding.EncodeToString([]byte("not a certificate"))); err == nil {
+		t.Error("Expected error for invalid certificate, got nil")
+	}
+}
@@ -11,19 +11,22 @@ import (
 	"encoding/pem"
 	"fmt"
 	"net/http"
-	"time"
+	"strings"
 
 	"software.sslmate.com/src/go-pkcs12"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -34,6 +37,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CertificateResource{}
 var _ resource.ResourceWithImportState = &CertificateResource{}
+var _ resource.ResourceWithValidateConfig = &CertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CertificateResource{}
 
 // NewCertificateResource creates a new Certificate resource.
 func NewCertificateResource() resource.Resource {
@@ -50,19 +55,43 @@ type CertificateResourceModel struct {
 	ID                    types.String `tfsdk:"id"`
 	CertificateType       types.String `tfsdk:"certificate_type"`
 	CsrContent            types.String `tfsdk:"csr_content"`
+	GenerateKey           types.Object `tfsdk:"generate_key"`
 	PrivateKeyPEM         types.String `tfsdk:"private_key_pem"`
 	CertificateContent    types.String `tfsdk:"certificate_content"`
 	CertificateContentPEM types.String `tfsdk:"certificate_content_pem"`
 	CertificateCAIssuers  types.List   `tfsdk:"certificate_ca_issuers"`
+	CertificateChainPEM   types.String `tfsdk:"certificate_chain_pem"`
 	DisplayName           types.String `tfsdk:"display_name"`
 	Name                  types.String `tfsdk:"name"`
 	Platform              types.String `tfsdk:"platform"`
 	SerialNumber          types.String `tfsdk:"serial_number"`
 	ExpirationDate        types.String `tfsdk:"expiration_date"`
 	RecreateThreshold     types.Int64  `tfsdk:"recreate_threshold"`
+	RevokeOnDestroy       types.Bool   `tfsdk:"revoke_on_destroy"`
 	Relationships         types.Object `tfsdk:"relationships"`
 	PKCS12BundlePassword  types.String `tfsdk:"pkcs12_bundle_password"`
 	PKCS12BundleContent   types.String `tfsdk:"pkcs12_bundle_content"`
+	PKCS12Encoding        types.String `tfsdk:"pkcs12_encoding"`
+	PKCS12IncludeChain    types.Bool   `tfsdk:"pkcs12_include_chain"`
+
+	PrivateKeyPEMWO types.String `tfsdk:"private_key_pem_wo"`
+
+	CertificateDetailsModel
+}
+
+// certificateErrorPointers maps JSON:API error source pointers to resource attributes.
+var certificateErrorPointers = map[string]path.Path{
+	"/data/attributes/certificateType":  path.Root("certificate_type"),
+	"/data/attributes/csrContent":       path.Root("csr_content"),
+	"/data/relationships/passTypeId":    path.Root("relationships").AtName("pass_type_id"),
+	"/data/relationships/passTypeId/id": path.Root("relationships").AtName("pass_type_id"),
+}
+
+// CertificateGenerateKeyModel describes the generate_key data model.
+type CertificateGenerateKeyModel struct {
+	Algorithm    types.String `tfsdk:"algorithm"`
+	CommonName   types.String `tfsdk:"common_name"`
+	EmailAddress types.String `tfsdk:"email_address"`
 }
 
 // CertificateRelationshipsModel describes the relationships data model.
@@ -110,17 +139,71 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"csr_content": schema.StringAttribute{
-				MarkdownDescription: "The certificate signing request (CSR) content in PEM format.",
-				Required:            true,
+				MarkdownDescription: "The certificate signing request (CSR) content in PEM format. The CSR is validated before it is sent to Apple: its signature must be valid and its key must be accepted for `certificate_type` (Apple requires RSA 2048-bit keys for every certificate type). Exactly one of `csr_content` or `generate_key` must be set; when `generate_key` is used, this is the generated CSR.",
+				Optional:            true,
+				Computed:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("csr_content"),
+						path.MatchRoot("generate_key"),
+					),
+					NewCSRContentValidator(),
+				},
+			},
+			"generate_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "Generate the private key and CSR locally instead of providing `csr_content`. The generated key is available in `private_key_pem` and is used for the PKCS12 bundle. Changes to this value require certificate replacement.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.StringAttribute{
+						MarkdownDescription: "The key algorithm. The only valid value is `RSA_2048`, which Apple requires for every certificate type. Default is `RSA_2048`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(KeyAlgorithmRSA2048),
+						Validators: []validator.String{
+							stringvalidator.OneOf(KeyAlgorithmRSA2048),
+						},
+					},
+					"common_name": schema.StringAttribute{
+						MarkdownDescription: "The common name of the CSR subject.",
+						Required:            true,
+					},
+					"email_address": schema.StringAttribute{
+						MarkdownDescription: "The email address of the CSR subject.",
+						Optional:            true,
+					},
+				},
 			},
 			"private_key_pem": schema.StringAttribute{
-				MarkdownDescription: "The private key in PEM format. Only required if you want to generate a PKCS12 bundle. This is not sent to Apple's API and is only used locally for PKCS12 generation. Changes to this value do not require certificate replacement.",
+				MarkdownDescription: "The private key in PEM format. Only required if you want to generate a PKCS12 bundle. This is not sent to Apple's API and is only used locally for PKCS12 generation. Changes to this value do not require certificate replacement. The key must match the public key of `csr_content` and of the issued certificate. When `generate_key` is used, this is the generated key and cannot be set.",
 				Optional:            true,
+				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					NewGeneratedPrivateKeyPlanModifier(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("generate_key")),
+				},
+			},
+			"private_key_pem_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `private_key_pem`. The key is checked against `csr_content` and the issued certificate but is never stored in the plan or state. Requires Terraform 1.11 or later. No PKCS12 bundle is generated from a write-only key, since the bundle contains the key and would be stored in state, so `pkcs12_bundle_password` cannot be set with this attribute; use the `appleappstoreconnect_certificate_bundle` ephemeral resource to build the bundle instead.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("private_key_pem"),
+						path.MatchRoot("generate_key"),
+					),
+				},
 			},
 			"certificate_content": schema.StringAttribute{
 				MarkdownDescription: "The certificate content in base64 encoded DER format.",
@@ -137,6 +220,10 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"certificate_chain_pem": schema.StringAttribute{
+				MarkdownDescription: "The issuing chain of the certificate in base64 encoded PEM format: the Apple WWDR intermediate certificate followed by the Apple root certificate. The chain is built offline from the Apple CA certificates embedded in the provider by matching the certificate's Authority Key Identifier. Null when no issuing certificate is found.",
+				Computed:            true,
+			},
 			"display_name": schema.StringAttribute{
 				MarkdownDescription: "The display name of the certificate.",
 				Computed:            true,
@@ -157,7 +244,7 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The expiration date of the certificate.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					NewCertificateRecreateThresholdPlanModifier(),
+					NewRecreateThresholdPlanModifier("certificate"),
 				},
 			},
 			"recreate_threshold": schema.Int64Attribute{
@@ -173,6 +260,12 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 					int64validator.AtLeast(0),
 				},
 			},
+			"revoke_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to revoke the certificate in App Store Connect when the resource is destroyed or replaced. When `false`, the certificate is only removed from Terraform state. Some certificate types cannot be revoked through the API and must be revoked in the Apple Developer portal. Default is `false`. Changes to this value do not require certificate replacement.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"relationships": schema.SingleNestedAttribute{
 				MarkdownDescription: "The relationships for the certificate.",
 				Optional:            true,
@@ -188,17 +281,41 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"pkcs12_bundle_password": schema.StringAttribute{
-				MarkdownDescription: "Password to use for the PKCS12 bundle. When provided, a PKCS12 bundle will be generated and available in the `pkcs12_bundle_content` attribute. Changes to this value do not require certificate replacement.",
+				MarkdownDescription: "Password to use for the PKCS12 bundle. When provided together with `private_key_pem` or `generate_key`, a PKCS12 bundle will be generated and available in the `pkcs12_bundle_content` attribute. Cannot be used with `private_key_pem_wo`. Changes to this value do not require certificate replacement.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"pkcs12_encoding": schema.StringAttribute{
+				MarkdownDescription: "The encryption used for the PKCS12 bundle. Valid values are: `modern` (AES-256 and SHA-256), `legacy_des` (3DES) and `legacy_rc2` (40-bit RC2 and 3DES). Use a legacy encoding for older macOS Keychain versions and Java keystores that cannot open modern bundles. Default is `modern`. Changes to this value do not require certificate replacement.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(PKCS12EncodingModern),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						PKCS12EncodingModern,
+						PKCS12EncodingLegacyDES,
+						PKCS12EncodingLegacyRC2,
+					),
+				},
+			},
+			"pkcs12_include_chain": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include the certificate chain from `certificate_chain_pem` in the PKCS12 bundle. The chain is built from the Apple CA certificates embedded in the provider: if they cannot provide one, creating a certificate fails before it is requested, and when no issuer is found for an issued certificate the bundle is generated without the chain and a warning is shown. Default is `false`. Changes to this value do not require certificate replacement.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"pkcs12_bundle_content": schema.StringAttribute{
-				MarkdownDescription: "The PKCS12 bundle content in base64 encoded format. Only available when `pkcs12_bundle_password` is provided.",
+				MarkdownDescription: "The PKCS12 bundle content in base64 encoded format. Only available when `private_key_pem` or `generate_key` and `pkcs12_bundle_password` are provided. The bundle contains the private key, protected only by the bundle password, and is stored in state, so protect the state accordingly. To keep the key out of state, use the `appleappstoreconnect_certificate_bundle` ephemeral resource instead.",
 				Computed:            true,
 				Sensitive:           true,
 			},
 		},
 	}
+
+	// Add the X.509 details parsed from the certificate content
+	for name, attribute := range certificateDetailsResourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -221,6 +338,60 @@ func (r *CertificateResource) Configure(ctx context.Context, req resource.Config
 	r.client = client
 }
 
+func (r *CertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A bundle built from a write-only key would still store the key in state
+	if !data.PrivateKeyPEMWO.IsNull() && !data.PKCS12BundlePassword.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pkcs12_bundle_password"),
+			"Invalid Attribute Combination",
+			"A PKCS12 bundle cannot be generated from private_key_pem_wo, since the bundle contains the private key and is stored in state. "+
+				"Remove pkcs12_bundle_password and use the appleappstoreconnect_certificate_bundle ephemeral resource to build the bundle instead.",
+		)
+		return
+	}
+
+	// The certificate is not known until it is issued, so only the CSR can be checked here
+	keyPath, privateKeyPEM := certificatePrivateKey(&data, data.PrivateKeyPEMWO)
+	resp.Diagnostics.Append(validatePrivateKey(keyPath, privateKeyPEM, data.CsrContent, types.StringNull())...)
+}
+
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when creating or destroying the resource
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var privateKeyPEMWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_pem_wo"), &privateKeyPEMWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new certificate is issued for the new CSR, so the key cannot be checked against
+	// the current one. It is checked against the CSR in ValidateConfig and Create.
+	if !plan.CsrContent.Equal(state.CsrContent) ||
+		!plan.CertificateType.Equal(state.CertificateType) ||
+		!plan.GenerateKey.Equal(state.GenerateKey) {
+		return
+	}
+
+	// Changing private_key_pem updates the existing certificate in place, so check
+	// the new key against the issued certificate. The CSR is checked in ValidateConfig.
+	keyPath, privateKeyPEM := certificatePrivateKey(&plan, privateKeyPEMWO)
+	resp.Diagnostics.Append(validatePrivateKey(keyPath, privateKeyPEM, types.StringNull(), state.CertificateContent)...)
+}
+
 func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CertificateResourceModel
 
@@ -251,6 +422,60 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Generate the private key and CSR locally if requested
+	if !data.GenerateKey.IsNull() && !data.GenerateKey.IsUnknown() {
+		var generateKey CertificateGenerateKeyModel
+		resp.Diagnostics.Append(data.GenerateKey.As(ctx, &generateKey, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		privateKeyPEM, csrPEM, err := generateKeyAndCSR(
+			generateKey.Algorithm.ValueString(),
+			generateKey.CommonName.ValueString(),
+			generateKey.EmailAddress.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("generate_key"),
+				"Key Generation Error",
+				fmt.Sprintf("Unable to generate private key and CSR: %s", err),
+			)
+			return
+		}
+
+		data.PrivateKeyPEM = types.StringValue(privateKeyPEM)
+		data.CsrContent = types.StringValue(csrPEM)
+	}
+
+	// Read the write-only key, which is only available in the configuration
+	var privateKeyPEMWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_pem_wo"), &privateKeyPEMWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values unknown at plan time are checked against the CSR before it is submitted
+	keyPath, privateKeyPEM := certificatePrivateKey(&data, privateKeyPEMWO)
+	resp.Diagnostics.Append(validatePrivateKey(keyPath, privateKeyPEM, data.CsrContent, types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The chain cannot be checked once the certificate is issued without orphaning it,
+	// so make sure the embedded Apple CA certificates can provide one beforehand
+	if data.PKCS12IncludeChain.ValueBool() {
+		if err := checkAppleCertificateChains(); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pkcs12_include_chain"),
+				"Certificate Chain Unavailable",
+				fmt.Sprintf("pkcs12_include_chain is enabled, but the provider cannot build certificate chains: %s. "+
+					"No certificate was requested. Set pkcs12_include_chain = false, or use a provider version that embeds the Apple CA certificates.", err),
+			)
+			return
+		}
+	}
+
 	// Create the request
 	createReq := CertificateCreateRequest{
 		Data: CertificateCreateRequestData{
@@ -286,10 +511,7 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		Body:     createReq,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Client Error",
-			fmt.Sprintf("Unable to create Certificate, got error: %s", err),
-		)
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to create Certificate", err, certificateErrorPointers)
 		return
 	}
 
@@ -311,47 +533,65 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 	data.Platform = types.StringValue(cert.Attributes.Platform)
 	data.SerialNumber = types.StringValue(cert.Attributes.SerialNumber)
 
+	// From here on the certificate exists in App Store Connect, so failures to derive
+	// values from it are reported as warnings and the certificate is saved to state
+
 	// Convert DER to PEM format
+	data.CertificateContentPEM = types.StringNull()
 	if cert.Attributes.CertificateContent != "" {
 		pemContent, err := convertDERToPEM(cert.Attributes.CertificateContent)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.AddWarning(
 				"Certificate Conversion Error",
-				fmt.Sprintf("Unable to convert certificate to PEM format: %s", err),
+				fmt.Sprintf("Unable to convert certificate to PEM format: %s\n\nThe certificate has been issued and saved to Terraform state without certificate_content_pem.", err),
 			)
-			return
+		} else {
+			data.CertificateContentPEM = types.StringValue(pemContent)
 		}
-		data.CertificateContentPEM = types.StringValue(pemContent)
-	} else {
-		data.CertificateContentPEM = types.StringNull()
 	}
 
 	// Extract certificate CA issuers
+	data.CertificateCAIssuers = types.ListNull(types.StringType)
 	if cert.Attributes.CertificateContent != "" {
 		caIssuers, err := extractCertificateCAIssuers(cert.Attributes.CertificateContent)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.AddWarning(
 				"Certificate CA Issuers Parsing Error",
-				fmt.Sprintf("Unable to parse certificate CA issuers: %s", err),
+				fmt.Sprintf("Unable to parse certificate CA issuers: %s\n\nThe certificate has been issued and saved to Terraform state without certificate_ca_issuers.", err),
 			)
-			return
+		} else {
+			// Convert []string to types.List
+			issuerValues := make([]attr.Value, len(caIssuers))
+			for i, issuer := range caIssuers {
+				issuerValues[i] = types.StringValue(issuer)
+			}
+
+			issuerList, diags := types.ListValue(types.StringType, issuerValues)
+			resp.Diagnostics.Append(issuedCertificateDiagnostics(diags)...)
+			if !diags.HasError() {
+				data.CertificateCAIssuers = issuerList
+			}
 		}
+	}
 
-		// Convert []string to types.List
-		issuerValues := make([]attr.Value, len(caIssuers))
-		for i, issuer := range caIssuers {
-			issuerValues[i] = types.StringValue(issuer)
-		}
+	// Build the certificate chain from the embedded Apple CA certificates
+	if err := updateCertificateChain(&data); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Certificate Chain Error",
+			fmt.Sprintf("Unable to build certificate chain: %s\n\nThe certificate has been issued and saved to Terraform state without certificate_chain_pem.", err),
+		)
+	}
 
-		issuerList, diags := types.ListValue(types.StringType, issuerValues)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.CertificateCAIssuers = issuerList
-	} else {
-		data.CertificateCAIssuers = types.ListNull(types.StringType)
+	// Parse the X.509 details of the certificate
+	details, err := parseCertificateDetails(cert.Attributes.CertificateContent)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Certificate Parsing Error",
+			fmt.Sprintf("Unable to parse certificate details: %s\n\nThe certificate has been issued and saved to Terraform state without the certificate details.", err),
+		)
+		details = nullCertificateDetails()
 	}
+	data.CertificateDetailsModel = details
 
 	if cert.Attributes.ExpirationDate != nil {
 		data.ExpirationDate = types.StringValue(cert.Attributes.ExpirationDate.Format("2006-01-02T15:04:05Z"))
@@ -366,14 +606,10 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	// Note: recreate_threshold is preserved from plan as it's not returned by Apple API
 
+	resp.Diagnostics.Append(issuedCertificateDiagnostics(validatePrivateKey(keyPath, privateKeyPEM, types.StringNull(), data.CertificateContent))...)
+
 	// Generate PKCS12 bundle if needed
-	if err := updatePKCS12Bundle(&data); err != nil {
-		resp.Diagnostics.AddError(
-			"PKCS12 Bundle Generation Error",
-			fmt.Sprintf("Unable to generate PKCS12 bundle: %s", err),
-		)
-		return
-	}
+	resp.Diagnostics.Append(issuedCertificateDiagnostics(updatePKCS12Bundle(&data))...)
 
 	tflog.Trace(ctx, "Created Certificate", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -411,6 +647,10 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 		},
 	})
 	if err != nil {
+		if removeResourceIfNotFound(ctx, resp, err, "Certificate", data.ID.ValueString()) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Client Error",
 			fmt.Sprintf("Unable to read Certificate, got error: %s", err),
@@ -436,47 +676,67 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 	data.Platform = types.StringValue(cert.Attributes.Platform)
 	data.SerialNumber = types.StringValue(cert.Attributes.SerialNumber)
 
+	// Failures to derive values from the certificate are reported as warnings, so a
+	// certificate Apple returns in a format the provider cannot parse does not block
+	// every plan; the derived attributes are set to null instead
+
 	// Convert DER to PEM format
+	data.CertificateContentPEM = types.StringNull()
 	if cert.Attributes.CertificateContent != "" {
 		pemContent, err := convertDERToPEM(cert.Attributes.CertificateContent)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.AddWarning(
 				"Certificate Conversion Error",
-				fmt.Sprintf("Unable to convert certificate to PEM format: %s", err),
+				fmt.Sprintf("Unable to convert certificate to PEM format: %s\n\nThe certificate has been kept in Terraform state without certificate_content_pem.", err),
 			)
-			return
+		} else {
+			data.CertificateContentPEM = types.StringValue(pemContent)
 		}
-		data.CertificateContentPEM = types.StringValue(pemContent)
-	} else {
-		data.CertificateContentPEM = types.StringNull()
 	}
 
 	// Extract certificate CA issuers
+	data.CertificateCAIssuers = types.ListNull(types.StringType)
 	if cert.Attributes.CertificateContent != "" {
 		caIssuers, err := extractCertificateCAIssuers(cert.Attributes.CertificateContent)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.AddWarning(
 				"Certificate CA Issuers Parsing Error",
-				fmt.Sprintf("Unable to parse certificate CA issuers: %s", err),
+				fmt.Sprintf("Unable to parse certificate CA issuers: %s\n\nThe certificate has been kept in Terraform state without certificate_ca_issuers.", err),
 			)
-			return
+		} else {
+			// Convert []string to types.List
+			issuerValues := make([]attr.Value, len(caIssuers))
+			for i, issuer := range caIssuers {
+				issuerValues[i] = types.StringValue(issuer)
+			}
+
+			issuerList, diags := types.ListValue(types.StringType, issuerValues)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.CertificateCAIssuers = issuerList
 		}
+	}
 
-		// Convert []string to types.List
-		issuerValues := make([]attr.Value, len(caIssuers))
-		for i, issuer := range caIssuers {
-			issuerValues[i] = types.StringValue(issuer)
-		}
+	// Build the certificate chain from the embedded Apple CA certificates
+	if err := updateCertificateChain(&data); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Certificate Chain Error",
+			fmt.Sprintf("Unable to build certificate chain: %s\n\nThe certificate has been kept in Terraform state without certificate_chain_pem.", err),
+		)
+	}
 
-		issuerList, diags := types.ListValue(types.StringType, issuerValues)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.CertificateCAIssuers = issuerList
-	} else {
-		data.CertificateCAIssuers = types.ListNull(types.StringType)
+	// Parse the X.509 details of the certificate
+	details, err := parseCertificateDetails(cert.Attributes.CertificateContent)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Certificate Parsing Error",
+			fmt.Sprintf("Unable to parse certificate details: %s\n\nThe certificate has been kept in Terraform state without the certificate details.", err),
+		)
+		details = nullCertificateDetails()
 	}
+	data.CertificateDetailsModel = details
 
 	if cert.Attributes.ExpirationDate != nil {
 		data.ExpirationDate = types.StringValue(cert.Attributes.ExpirationDate.Format("2006-01-02T15:04:05Z"))
@@ -497,6 +757,19 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 		data.Relationships = relationshipsObj
 	}
 
+	// revoke_on_destroy is not returned by Apple API; default it after import
+	if data.RevokeOnDestroy.IsNull() {
+		data.RevokeOnDestroy = types.BoolValue(false)
+	}
+
+	// pkcs12_encoding and pkcs12_include_chain are local to the provider; default them after import
+	if data.PKCS12Encoding.IsNull() {
+		data.PKCS12Encoding = types.StringValue(PKCS12EncodingModern)
+	}
+	if data.PKCS12IncludeChain.IsNull() {
+		data.PKCS12IncludeChain = types.BoolValue(false)
+	}
+
 	// Restore PKCS12-related fields from existing state to avoid unnecessary changes
 	// PKCS12 bundle generation only happens during Create/Update operations
 	data.PrivateKeyPEM = existingPrivateKeyPEM
@@ -531,7 +804,7 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 	if certificateFieldsChanged {
 		resp.Diagnostics.AddError(
 			"Update Not Supported",
-			"The certificate itself cannot be updated. Only pkcs12_bundle_password and private_key_pem can be modified without replacement. To change the certificate, you must delete and recreate the resource.",
+			"The certificate itself cannot be updated. Only pkcs12_bundle_password, private_key_pem, private_key_pem_wo, pkcs12_encoding, pkcs12_include_chain and revoke_on_destroy can be modified without replacement. To change the certificate, you must delete and recreate the resource.",
 		)
 		return
 	}
@@ -541,6 +814,8 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.CertificateContent = state.CertificateContent
 	plan.CertificateContentPEM = state.CertificateContentPEM
 	plan.CertificateCAIssuers = state.CertificateCAIssuers
+	plan.CertificateChainPEM = state.CertificateChainPEM
+	plan.CertificateDetailsModel = state.CertificateDetailsModel
 	plan.DisplayName = state.DisplayName
 	plan.Name = state.Name
 	plan.Platform = state.Platform
@@ -549,12 +824,22 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.RecreateThreshold = state.RecreateThreshold
 	plan.Relationships = state.Relationships
 
+	// Read the write-only key, which is only available in the configuration
+	var privateKeyPEMWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_pem_wo"), &privateKeyPEMWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyPath, privateKeyPEM := certificatePrivateKey(&plan, privateKeyPEMWO)
+	resp.Diagnostics.Append(validatePrivateKey(keyPath, privateKeyPEM, plan.CsrContent, plan.CertificateContent)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate PKCS12 bundle with the new values
-	if err := updatePKCS12Bundle(&plan); err != nil {
-		resp.Diagnostics.AddError(
-			"PKCS12 Bundle Generation Error",
-			fmt.Sprintf("Unable to generate PKCS12 bundle: %s", err),
-		)
+	resp.Diagnostics.Append(updatePKCS12Bundle(&plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -576,22 +861,60 @@ func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	tflog.Debug(ctx, "Removing Certificate from Terraform state", map[string]interface{}{
-		"id": data.ID.ValueString(),
-	})
+	if !data.RevokeOnDestroy.ValueBool() {
+		tflog.Debug(ctx, "Removing Certificate from Terraform state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
 
-	// Certificates cannot be revoked programmatically through the App Store Connect API.
-	// According to Apple's documentation, certificates can only be revoked by Apple Developer Program Support.
-	// Therefore, we only remove the certificate from Terraform state.
+		// Revocation is opt-in, so by default only remove the certificate from Terraform state
+		resp.Diagnostics.AddWarning(
+			"Certificate Not Revoked",
+			"The certificate has been removed from Terraform state but has not been revoked. "+
+				"Set revoke_on_destroy = true to revoke certificates through the App Store Connect API when they are destroyed, "+
+				"or revoke this certificate in the Apple Developer portal.",
+		)
 
-	// Add a warning to inform users about this limitation
-	resp.Diagnostics.AddWarning(
-		"Certificate Not Revoked",
-		"The certificate has been removed from Terraform state, but it cannot be revoked programmatically through the App Store Connect API. "+
-			"If you need to revoke this certificate, you must contact Apple Developer Program Support at https://developer.apple.com/support",
-	)
+		tflog.Trace(ctx, "Removed Certificate from Terraform state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking Certificate", map[string]interface{}{
+		"id":               data.ID.ValueString(),
+		"certificate_type": data.CertificateType.ValueString(),
+	})
+
+	// Make the API request
+	_, err := r.client.Do(ctx, Request{
+		Method:   http.MethodDelete,
+		Endpoint: fmt.Sprintf("/certificates/%s", data.ID.ValueString()),
+	})
+	if err != nil {
+		switch {
+		case IsNotFound(err):
+			// The certificate has already been revoked or has expired
+			tflog.Debug(ctx, "Certificate already revoked", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+		case IsForbidden(err) || IsConflict(err):
+			resp.Diagnostics.AddError(
+				"Certificate Revocation Not Supported",
+				fmt.Sprintf("App Store Connect refused to revoke the %s certificate %s. Certificates of this type cannot be revoked through the API; "+
+					"revoke it in the Apple Developer portal, or set revoke_on_destroy = false to only remove it from Terraform state.\n\n"+
+					"Error: %s", data.CertificateType.ValueString(), data.ID.ValueString(), err),
+			)
+			return
+		default:
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to revoke Certificate, got error: %s", err),
+			)
+			return
+		}
+	}
 
-	tflog.Trace(ctx, "Removed Certificate from Terraform state", map[string]interface{}{
+	tflog.Trace(ctx, "Revoked Certificate", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
 }
@@ -600,8 +923,34 @@ func (r *CertificateResource) ImportState(ctx context.Context, req resource.Impo
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// generatePKCS12Bundle creates a PKCS12 bundle from certificate and private key.
-func generatePKCS12Bundle(certPEM, privateKeyPEM, password string) (string, error) {
+// pkcs12Encoder returns the go-pkcs12 encoder for a pkcs12_encoding value.
+// An empty encoding selects the modern encoder.
+func pkcs12Encoder(encoding string) (*pkcs12.Encoder, error) {
+	switch encoding {
+	case "", PKCS12EncodingModern:
+		return pkcs12.Modern, nil
+	case PKCS12EncodingLegacyDES:
+		return pkcs12.LegacyDES, nil
+	case PKCS12EncodingLegacyRC2:
+		return pkcs12.LegacyRC2, nil
+	default:
+		return nil, fmt.Errorf("unsupported PKCS12 encoding: %s", encoding)
+	}
+}
+
+// generatePKCS12Bundle creates a PKCS12 bundle from certificate, private key and
+// optional CA certificates using the given pkcs12_encoding.
+func generatePKCS12Bundle(certPEM, privateKeyPEM, password, encoding string, caCerts []*x509.Certificate) (string, error) {
+	encoder, err := pkcs12Encoder(encoding)
+	if err != nil {
+		return "", err
+	}
+
+	return encodePKCS12Bundle(encoder, certPEM, privateKeyPEM, password, caCerts)
+}
+
+// encodePKCS12Bundle creates a PKCS12 bundle with encoder and returns it base64 encoded.
+func encodePKCS12Bundle(encoder *pkcs12.Encoder, certPEM, privateKeyPEM, password string, caCerts []*x509.Certificate) (string, error) {
 	// Parse certificate
 	certBlock, _ := pem.Decode([]byte(certPEM))
 	if certBlock == nil {
@@ -614,29 +963,18 @@ func generatePKCS12Bundle(certPEM, privateKeyPEM, password string) (string, erro
 	}
 
 	// Parse private key
-	keyBlock, _ := pem.Decode([]byte(privateKeyPEM))
-	if keyBlock == nil {
-		return "", fmt.Errorf("failed to decode private key PEM")
-	}
-
-	var privateKey interface{}
-	switch keyBlock.Type {
-	case "RSA PRIVATE KEY":
-		privateKey, err = x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
-	case "EC PRIVATE KEY":
-		privateKey, err = x509.ParseECPrivateKey(keyBlock.Bytes)
-	case "PRIVATE KEY":
-		privateKey, err = x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
-	default:
-		return "", fmt.Errorf("unsupported private key type: %s", keyBlock.Type)
+	privateKey, err := parsePrivateKeyPEM(privateKeyPEM)
+	if err != nil {
+		return "", err
 	}
 
-	if err != nil {
-		return "", fmt.Errorf("failed to parse private key: %w", err)
+	// A mismatched key produces a bundle that fails when signing passes
+	if !publicKeyMatches(privateKey, cert.PublicKey) {
+		return "", fmt.Errorf("private key does not match the certificate public key")
 	}
 
 	// Create PKCS12
-	p12Data, err := pkcs12.Modern.Encode(privateKey, cert, nil, password)
+	p12Data, err := encoder.Encode(privateKey, cert, caCerts, password)
 	if err != nil {
 		return "", fmt.Errorf("failed to encode PKCS12: %w", err)
 	}
@@ -646,147 +984,165 @@ func generatePKCS12Bundle(certPEM, privateKeyPEM, password string) (string, erro
 }
 
 // updatePKCS12Bundle generates PKCS12 bundle if both password and private key are provided.
-func updatePKCS12Bundle(data *CertificateResourceModel) error {
-	// Only generate PKCS12 if both password and private key are provided, and certificate is available
-	if !data.PKCS12BundlePassword.IsNull() && !data.PKCS12BundlePassword.IsUnknown() &&
-		!data.PrivateKeyPEM.IsNull() && !data.PrivateKeyPEM.IsUnknown() &&
-		!data.CertificateContentPEM.IsNull() && !data.CertificateContentPEM.IsUnknown() {
+// Only the stored private key is used: a bundle built from private_key_pem_wo would put
+// the key in state after all.
+// When pkcs12_include_chain is set but the chain cannot be built from the embedded
+// Apple CA certificates, the bundle is generated without it and a warning is returned.
+func updatePKCS12Bundle(data *CertificateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-		// Decode the base64-encoded PEM to get the raw PEM string
-		certPEMBytes, err := base64.StdEncoding.DecodeString(data.CertificateContentPEM.ValueString())
-		if err != nil {
-			return fmt.Errorf("failed to decode base64 certificate PEM: %w", err)
-		}
+	privateKeyPEM := data.PrivateKeyPEM
+	password := data.PKCS12BundlePassword
+
+	data.PKCS12BundleContent = types.StringNull()
 
-		pkcs12Content, err := generatePKCS12Bundle(
-			string(certPEMBytes),
-			data.PrivateKeyPEM.ValueString(),
-			data.PKCS12BundlePassword.ValueString(),
+	// Only generate PKCS12 if both password and private key are provided, and certificate is available
+	if password.IsNull() || password.IsUnknown() ||
+		privateKeyPEM.IsNull() || privateKeyPEM.IsUnknown() ||
+		data.CertificateContentPEM.IsNull() || data.CertificateContentPEM.IsUnknown() {
+		return diags
+	}
+
+	// Decode the base64-encoded PEM to get the raw PEM string
+	certPEMBytes, err := base64.StdEncoding.DecodeString(data.CertificateContentPEM.ValueString())
+	if err != nil {
+		diags.AddError(
+			"PKCS12 Bundle Generation Error",
+			fmt.Sprintf("Unable to generate PKCS12 bundle: failed to decode base64 certificate PEM: %s", err),
 		)
-		if err != nil {
-			return fmt.Errorf("failed to generate PKCS12 bundle: %w", err)
+		return diags
+	}
+
+	var caCerts []*x509.Certificate
+	if data.PKCS12IncludeChain.ValueBool() {
+		chain, err := appleCertificateChain(data.CertificateContent.ValueString())
+		switch {
+		case err != nil:
+			diags.AddAttributeWarning(
+				path.Root("pkcs12_include_chain"),
+				"Certificate Chain Not Included",
+				fmt.Sprintf("Unable to build the certificate chain, so the PKCS12 bundle was generated without it: %s", err),
+			)
+		case len(chain) == 0:
+			diags.AddAttributeWarning(
+				path.Root("pkcs12_include_chain"),
+				"Certificate Chain Not Included",
+				"No issuing certificate for this certificate was found among the Apple CA certificates embedded in the provider, so the PKCS12 bundle was generated without the chain. "+
+					"Upgrade to a provider version that includes the issuing Apple WWDR certificate.",
+			)
+		default:
+			caCerts = chain
 		}
-		data.PKCS12BundleContent = types.StringValue(pkcs12Content)
-	} else {
-		data.PKCS12BundleContent = types.StringNull()
 	}
-	return nil
-}
 
-// CertificateRecreateThresholdPlanModifier is a custom plan modifier that triggers replacement
-// when the certificate is within the recreate threshold of expiration.
-type CertificateRecreateThresholdPlanModifier struct{}
+	pkcs12Content, err := generatePKCS12Bundle(
+		string(certPEMBytes),
+		privateKeyPEM.ValueString(),
+		password.ValueString(),
+		data.PKCS12Encoding.ValueString(),
+		caCerts,
+	)
+	if err != nil {
+		diags.AddError(
+			"PKCS12 Bundle Generation Error",
+			fmt.Sprintf("Unable to generate PKCS12 bundle: %s", err),
+		)
+		return diags
+	}
+	data.PKCS12BundleContent = types.StringValue(pkcs12Content)
 
-// NewCertificateRecreateThresholdPlanModifier creates a new instance of the plan modifier.
-func NewCertificateRecreateThresholdPlanModifier() planmodifier.String {
-	return CertificateRecreateThresholdPlanModifier{}
+	return diags
 }
 
-// Description returns a human-readable description of the plan modifier.
-func (m CertificateRecreateThresholdPlanModifier) Description(ctx context.Context) string {
-	return "Recreates the certificate when it is within the recreate threshold of expiration."
-}
+// issuedCertificateDiagnostics turns the errors in diags into warnings. Once App Store
+// Connect has issued a certificate, Create must save it to state: returning an error
+// instead would leave an orphaned certificate that counts against the account limit.
+func issuedCertificateDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	const note = "\n\nThe certificate has been issued and saved to Terraform state without this value."
+
+	var result diag.Diagnostics
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			result.Append(d)
+			continue
+		}
 
-// MarkdownDescription returns a markdown description of the plan modifier.
-func (m CertificateRecreateThresholdPlanModifier) MarkdownDescription(ctx context.Context) string {
-	return "Recreates the certificate when it is within the recreate threshold of expiration."
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			result.AddAttributeWarning(withPath.Path(), d.Summary(), d.Detail()+note)
+		} else {
+			result.AddWarning(d.Summary(), d.Detail()+note)
+		}
+	}
+	return result
 }
 
-// PlanModifyString implements the plan modifier logic.
-func (m CertificateRecreateThresholdPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-	// If the resource is being created, don't modify the plan
-	if req.State.Raw.IsNull() {
-		return
+// certificatePrivateKey returns the configured private key and the attribute it was set in,
+// preferring private_key_pem over the write-only private_key_pem_wo.
+func certificatePrivateKey(data *CertificateResourceModel, privateKeyPEMWO types.String) (path.Path, types.String) {
+	if data.PrivateKeyPEM.IsNull() {
+		return path.Root("private_key_pem_wo"), privateKeyPEMWO
 	}
+	return path.Root("private_key_pem"), data.PrivateKeyPEM
+}
 
-	// If the resource is being destroyed, don't modify the plan
-	if req.Plan.Raw.IsNull() {
-		return
-	}
+// validatePrivateKey checks that the private key at keyPath matches the public key of the
+// CSR and of the issued certificate. Null, unknown or unparseable CSR and certificate
+// values are skipped; they are reported elsewhere.
+func validatePrivateKey(keyPath path.Path, privateKeyPEM, csrContent, certificateContent types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	// If the expiration date is not set, don't modify the plan
-	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
-		return
+	if privateKeyPEM.IsNull() || privateKeyPEM.IsUnknown() {
+		return diags
 	}
 
-	// Get the current state
-	var state CertificateResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	privateKey, err := parsePrivateKeyPEM(privateKeyPEM.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			keyPath,
+			"Invalid Private Key",
+			fmt.Sprintf("Unable to parse %s: %s", keyPath, err),
+		)
+		return diags
 	}
 
-	// Get the planned state
-	var plan CertificateResourceModel
-	diags = req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !csrContent.IsNull() && !csrContent.IsUnknown() {
+		if csr, err := parseCSRContent(csrContent.ValueString()); err == nil && !publicKeyMatches(privateKey, csr.PublicKey) {
+			diags.AddAttributeError(
+				keyPath,
+				"Private Key Mismatch",
+				fmt.Sprintf("The public key of %s does not match the public key of the CSR in csr_content. The PKCS12 bundle would contain a key that cannot be used with the issued certificate. Use the private key that was used to create the CSR.", keyPath),
+			)
+		}
 	}
 
-	// Get the recreate threshold (default to 30 days if not set)
-	var thresholdSeconds int64 = 2592000 // 30 days
-	if !plan.RecreateThreshold.IsNull() && !plan.RecreateThreshold.IsUnknown() {
-		thresholdSeconds = plan.RecreateThreshold.ValueInt64()
+	if certificateContent.ValueString() != "" && !certificateContent.IsUnknown() {
+		if cert, err := decodeCertificate(certificateContent.ValueString()); err == nil && !publicKeyMatches(privateKey, cert.PublicKey) {
+			diags.AddAttributeError(
+				keyPath,
+				"Private Key Mismatch",
+				fmt.Sprintf("The public key of %s does not match the public key of the issued certificate (serial number %s). The PKCS12 bundle would contain a key that cannot be used with the certificate. Use the private key that was used to create the CSR.", keyPath, strings.ToUpper(cert.SerialNumber.Text(16))),
+			)
+		}
 	}
 
-	// If threshold is 0, don't recreate
-	if thresholdSeconds == 0 {
-		return
-	}
+	return diags
+}
 
-	// Parse the expiration date
-	expirationStr := state.ExpirationDate.ValueString()
-	if expirationStr == "" {
-		return
-	}
+// updateCertificateChain sets certificate_chain_pem from the certificate content.
+func updateCertificateChain(data *CertificateResourceModel) error {
+	data.CertificateChainPEM = types.StringNull()
 
-	expirationDate, err := time.Parse("2006-01-02T15:04:05Z", expirationStr)
-	if err != nil {
-		tflog.Warn(ctx, "Failed to parse expiration date", map[string]interface{}{
-			"expiration_date": expirationStr,
-			"error":           err.Error(),
-		})
-		return
+	if data.CertificateContent.ValueString() == "" {
+		return nil
 	}
 
-	// Calculate the threshold time
-	thresholdTime := time.Now().Add(time.Duration(thresholdSeconds) * time.Second)
-
-	// If the certificate expires within the threshold, require replacement
-	if expirationDate.Before(thresholdTime) {
-		tflog.Info(ctx, "Certificate expiration is within recreate threshold, requiring replacement", map[string]interface{}{
-			"expiration_date":   expirationDate.Format("2006-01-02T15:04:05Z"),
-			"threshold_time":    thresholdTime.Format("2006-01-02T15:04:05Z"),
-			"threshold_seconds": thresholdSeconds,
-		})
-		resp.RequiresReplace = true
+	chain, err := appleCertificateChain(data.CertificateContent.ValueString())
+	if err != nil {
+		return err
 	}
-}
 
-// RecreateThresholdDefaultPlanModifier sets a default value for recreate_threshold.
-type RecreateThresholdDefaultPlanModifier struct{}
-
-// NewRecreateThresholdDefaultPlanModifier creates a new instance of the default plan modifier.
-func NewRecreateThresholdDefaultPlanModifier() planmodifier.Int64 {
-	return RecreateThresholdDefaultPlanModifier{}
-}
-
-// Description returns a human-readable description of the plan modifier.
-func (m RecreateThresholdDefaultPlanModifier) Description(ctx context.Context) string {
-	return "Sets default value of 2592000 (30 days) when recreate_threshold is not specified."
-}
-
-// MarkdownDescription returns a markdown description of the plan modifier.
-func (m RecreateThresholdDefaultPlanModifier) MarkdownDescription(ctx context.Context) string {
-	return "Sets default value of 2592000 (30 days) when recreate_threshold is not specified."
-}
-
-// PlanModifyInt64 implements the plan modifier logic.
-func (m RecreateThresholdDefaultPlanModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
-	// If the value is null or unknown, set the default
-	if req.ConfigValue.IsNull() {
-		resp.PlanValue = types.Int64Value(2592000) // 30 days
+	if len(chain) > 0 {
+		data.CertificateChainPEM = types.StringValue(encodeCertificateChainPEM(chain))
 	}
+	return nil
 }
@@ -4,12 +4,30 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"software.sslmate.com/src/go-pkcs12"
 )
 
 func TestAccCertificateResource(t *testing.T) {
@@ -136,3 +154,671 @@ func TestCertificateTypeValidation(t *testing.T) {
 		})
 	}
 }
+
+// testCertificateResourceModel returns a stored PASS_TYPE_ID certificate model with
+// every object, list and nested attribute set to null.
+func testCertificateResourceModel(id string) CertificateResourceModel {
+	return CertificateResourceModel{
+		ID:              types.StringValue(id),
+		CertificateType: types.StringValue(CertificateTypePassTypeID),
+		CsrContent:      types.StringValue("csr"),
+		GenerateKey: types.ObjectNull(map[string]attr.Type{
+			"algorithm":     types.StringType,
+			"common_name":   types.StringType,
+			"email_address": types.StringType,
+		}),
+		CertificateCAIssuers:    types.ListNull(types.StringType),
+		CertificateDetailsModel: nullCertificateDetails(),
+		RecreateThreshold:       types.Int64Value(2592000),
+		Relationships: types.ObjectNull(map[string]attr.Type{
+			"pass_type_id": types.StringType,
+		}),
+	}
+}
+
+// testCertificateResourceState returns state holding data in the certificate resource schema.
+func testCertificateResourceState(t *testing.T, data CertificateResourceModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&CertificateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+	return state
+}
+
+func TestCertificateResourceRead_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'certificates' with id 'CERT123'"}]}`))
+	})
+
+	r := &CertificateResource{client: client}
+	state := testCertificateResourceState(t, testCertificateResourceModel("CERT123"))
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
+
+func TestCertificateResourceRead_UnparseableCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"type":"certificates","id":"CERT123","attributes":{"certificateType":"PASS_TYPE_ID","certificateContent":"bm90IGEgY2VydGlmaWNhdGU=","serialNumber":"1A2B"}}}`))
+	})
+
+	r := &CertificateResource{client: client}
+	state := testCertificateResourceState(t, testCertificateResourceModel("CERT123"))
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	// The certificate still exists, so it must stay in state even though it cannot be parsed
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() == 0 {
+		t.Error("Expected warnings for the unparseable certificate, got none")
+	}
+
+	var got CertificateResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+	if got.SerialNumber.ValueString() != "1A2B" {
+		t.Errorf("serial_number = %s, want 1A2B", got.SerialNumber)
+	}
+	if !got.CertificateCAIssuers.IsNull() {
+		t.Errorf("certificate_ca_issuers = %s, want null", got.CertificateCAIssuers)
+	}
+	if !got.SubjectDN.IsNull() {
+		t.Errorf("subject_dn = %s, want null", got.SubjectDN)
+	}
+}
+
+func TestCertificateResourceDelete_RevokeOnDestroy(t *testing.T) {
+	tests := []struct {
+		name            string
+		revokeOnDestroy bool
+		status          int
+		body            string
+		wantRequest     bool
+		wantError       string
+		wantWarnings    int
+	}{
+		{
+			name:            "disabled",
+			revokeOnDestroy: false,
+			wantRequest:     false,
+			wantWarnings:    1,
+		},
+		{
+			name:            "revoked",
+			revokeOnDestroy: true,
+			status:          http.StatusNoContent,
+			wantRequest:     true,
+		},
+		{
+			name:            "already revoked",
+			revokeOnDestroy: true,
+			status:          http.StatusNotFound,
+			body:            `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist"}]}`,
+			wantRequest:     true,
+		},
+		{
+			name:            "not revocable",
+			revokeOnDestroy: true,
+			status:          http.StatusForbidden,
+			body:            `{"errors":[{"status":"403","code":"FORBIDDEN_ERROR","title":"This request is forbidden for security reasons","detail":"The resource 'certificates' does not allow 'DELETE'."}]}`,
+			wantRequest:     true,
+			wantError:       "Certificate Revocation Not Supported",
+		},
+		{
+			name:            "other error",
+			revokeOnDestroy: true,
+			status:          http.StatusBadRequest,
+			body:            `{"errors":[{"status":"400","code":"PARAMETER_ERROR.INVALID","title":"A parameter has an invalid value"}]}`,
+			wantRequest:     true,
+			wantError:       "Client Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			requested := false
+			client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requested = true
+				if r.Method != http.MethodDelete || r.URL.Path != "/v1/certificates/CERT123" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			r := &CertificateResource{client: client}
+
+			data := testCertificateResourceModel("CERT123")
+			data.RevokeOnDestroy = types.BoolValue(tt.revokeOnDestroy)
+			state := testCertificateResourceState(t, data)
+
+			resp := &fwresource.DeleteResponse{State: state}
+			r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+
+			if requested != tt.wantRequest {
+				t.Errorf("DELETE requested = %v, want %v", requested, tt.wantRequest)
+			}
+
+			if tt.wantError != "" {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("Expected error %q, got none", tt.wantError)
+				}
+				if got := resp.Diagnostics.Errors()[0].Summary(); got != tt.wantError {
+					t.Errorf("Error summary = %q, want %q", got, tt.wantError)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount(); got != tt.wantWarnings {
+				t.Errorf("Expected %d warnings, got %d", tt.wantWarnings, got)
+			}
+		})
+	}
+}
+
+// createTestCertificateAndKey creates a self-signed certificate and returns it
+// in PEM format together with its PKCS1 PEM private key.
+func createTestCertificateAndKey(t *testing.T) ([]byte, string, *rsa.PrivateKey) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "Pass Type ID: pass.io.truetickets.test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)}))
+
+	return certPEM, keyPEM, priv
+}
+
+func TestGeneratePKCS12Bundle_Encodings(t *testing.T) {
+	certPEM, keyPEM, priv := createTestCertificateAndKey(t)
+
+	certBlock, _ := pem.Decode(certPEM)
+
+	for _, encoding := range []string{PKCS12EncodingModern, PKCS12EncodingLegacyDES, PKCS12EncodingLegacyRC2} {
+		t.Run(encoding, func(t *testing.T) {
+			bundle, err := generatePKCS12Bundle(string(certPEM), keyPEM, "password", encoding, nil)
+			if err != nil {
+				t.Fatalf("generatePKCS12Bundle() error = %v", err)
+			}
+
+			p12, err := base64.StdEncoding.DecodeString(bundle)
+			if err != nil {
+				t.Fatalf("Bundle is not valid base64: %v", err)
+			}
+
+			key, cert, err := pkcs12.Decode(p12, "password")
+			if err != nil {
+				t.Fatalf("Failed to decode PKCS12 bundle: %v", err)
+			}
+			if !priv.Equal(key) {
+				t.Error("Decoded private key does not match the original key")
+			}
+			if !bytes.Equal(cert.Raw, certBlock.Bytes) {
+				t.Error("Decoded certificate does not match the original certificate")
+			}
+		})
+	}
+}
+
+func TestGeneratePKCS12Bundle_UnsupportedEncoding(t *testing.T) {
+	certPEM, keyPEM, _ := createTestCertificateAndKey(t)
+
+	if _, err := generatePKCS12Bundle(string(certPEM), keyPEM, "password", "legacy_md5", nil); err == nil {
+		t.Error("Expected error for unsupported encoding, got nil")
+	}
+}
+
+func TestCertificateResourceValidateConfig_WriteOnlyKey(t *testing.T) {
+	_, keyPEM, _ := createTestCertificateAndKey(t)
+
+	tests := []struct {
+		name      string
+		password  types.String
+		wantError bool
+	}{
+		{
+			name:     "without bundle password",
+			password: types.StringNull(),
+		},
+		{
+			name:      "with bundle password",
+			password:  types.StringValue("password"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testCertificateResourceModel("")
+			data.CsrContent = types.StringNull()
+			data.PrivateKeyPEMWO = types.StringValue(keyPEM)
+			data.PKCS12BundlePassword = tt.password
+			state := testCertificateResourceState(t, data)
+
+			req := fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}
+			resp := &fwresource.ValidateConfigResponse{}
+
+			(&CertificateResource{}).ValidateConfig(context.Background(), req, resp)
+
+			if !tt.wantError {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("Expected 1 error, got: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.Errors()[0].Summary(); got != "Invalid Attribute Combination" {
+				t.Errorf("Summary = %q, want %q", got, "Invalid Attribute Combination")
+			}
+		})
+	}
+}
+
+func TestUpdatePKCS12Bundle_ChainNotFound(t *testing.T) {
+	certPEM, keyPEM, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	// The self-signed test certificate has no issuer among the Apple CA certificates
+	data := CertificateResourceModel{
+		CertificateContent:    types.StringValue(base64.StdEncoding.EncodeToString(certBlock.Bytes)),
+		CertificateContentPEM: types.StringValue(base64.StdEncoding.EncodeToString(certPEM)),
+		PrivateKeyPEM:         types.StringValue(keyPEM),
+		PKCS12BundlePassword:  types.StringValue("password"),
+		PKCS12IncludeChain:    types.BoolValue(true),
+	}
+
+	diags := updatePKCS12Bundle(&data)
+	if diags.HasError() {
+		t.Fatalf("updatePKCS12Bundle() diagnostics: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", diags.WarningsCount())
+	}
+
+	p12, err := base64.StdEncoding.DecodeString(data.PKCS12BundleContent.ValueString())
+	if err != nil {
+		t.Fatalf("pkcs12_bundle_content is not valid base64: %v", err)
+	}
+	if _, _, caCerts, err := pkcs12.DecodeChain(p12, "password"); err != nil || len(caCerts) != 0 {
+		t.Errorf("Expected a bundle without a chain, got %d CA certificates and error %v", len(caCerts), err)
+	}
+}
+
+func TestIssuedCertificateDiagnostics(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddAttributeError(path.Root("private_key_pem"), "Private Key Mismatch", "detail")
+	diags.AddError("PKCS12 Bundle Generation Error", "detail")
+	diags.AddWarning("Certificate Chain Not Included", "detail")
+
+	got := issuedCertificateDiagnostics(diags)
+	if got.HasError() {
+		t.Fatalf("Expected no errors, got: %v", got)
+	}
+	if got.WarningsCount() != 3 {
+		t.Fatalf("Expected 3 warnings, got %d", got.WarningsCount())
+	}
+	if withPath, ok := got[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root("private_key_pem")) {
+		t.Errorf("Expected the first warning to keep its attribute path, got %v", got[0])
+	}
+}
+
+func TestCertificateResourceCreate_ChainUnavailable(t *testing.T) {
+	if checkAppleCertificateChains() == nil {
+		t.Skip("The embedded Apple CA certificates provide a chain")
+	}
+	ctx := context.Background()
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s: no certificate should be requested", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	r := &CertificateResource{client: client}
+
+	data := testCertificateResourceModel("")
+	data.ID = types.StringUnknown()
+	data.PKCS12IncludeChain = types.BoolValue(true)
+	data.Relationships = types.ObjectValueMust(
+		map[string]attr.Type{"pass_type_id": types.StringType},
+		map[string]attr.Value{"pass_type_id": types.StringValue("PASS123")},
+	)
+	plan := testCertificateResourceState(t, data)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected a certificate chain error, got none")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Certificate Chain Unavailable" {
+		t.Errorf("Summary = %q, want %q", got, "Certificate Chain Unavailable")
+	}
+}
+
+func TestCertificateResourceCreate_UnparseableCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"type":"certificates","id":"CERT1","attributes":{"certificateType":"PASS_TYPE_ID","certificateContent":"bm90IGEgY2VydGlmaWNhdGU=","serialNumber":"1A2B"}}}`))
+	})
+
+	r := &CertificateResource{client: client}
+
+	data := testCertificateResourceModel("")
+	data.ID = types.StringUnknown()
+	data.Relationships = types.ObjectValueMust(
+		map[string]attr.Type{"pass_type_id": types.StringType},
+		map[string]attr.Value{"pass_type_id": types.StringValue("PASS123")},
+	)
+	plan := testCertificateResourceState(t, data)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	// The certificate was issued, so it must be saved even though it cannot be parsed
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() == 0 {
+		t.Error("Expected warnings for the unparseable certificate, got none")
+	}
+
+	var got CertificateResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+	if got.ID.ValueString() != "CERT1" {
+		t.Errorf("id = %s, want CERT1", got.ID)
+	}
+	if !got.SubjectDN.IsNull() {
+		t.Errorf("subject_dn = %s, want null", got.SubjectDN)
+	}
+}
+
+func TestCertificateResourceCreate_IssuedKeyMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	_, otherKeyPEM, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"data":{"type":"certificates","id":"CERT1","attributes":{"certificateType":"PASS_TYPE_ID","certificateContent":%q}}}`,
+			base64.StdEncoding.EncodeToString(certBlock.Bytes))
+	})
+
+	r := &CertificateResource{client: client}
+
+	data := testCertificateResourceModel("")
+	data.ID = types.StringUnknown()
+	data.PrivateKeyPEM = types.StringValue(otherKeyPEM)
+	data.PKCS12BundlePassword = types.StringValue("password")
+	data.Relationships = types.ObjectValueMust(
+		map[string]attr.Type{"pass_type_id": types.StringType},
+		map[string]attr.Value{"pass_type_id": types.StringValue("PASS123")},
+	)
+	plan := testCertificateResourceState(t, data)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	// The issued certificate must be saved, with the mismatch reported as a warning
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	var mismatch bool
+	for _, d := range resp.Diagnostics.Warnings() {
+		mismatch = mismatch || d.Summary() == "Private Key Mismatch"
+	}
+	if !mismatch {
+		t.Errorf("Expected a private key mismatch warning, got: %v", resp.Diagnostics)
+	}
+
+	var got CertificateResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+	if got.ID.ValueString() != "CERT1" {
+		t.Errorf("id = %s, want CERT1", got.ID)
+	}
+	if !got.PKCS12BundleContent.IsNull() {
+		t.Error("Expected no PKCS12 bundle for a mismatched key")
+	}
+}
+
+func TestGeneratePKCS12Bundle_KeyMismatch(t *testing.T) {
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	_, otherKeyPEM, _ := createTestCertificateAndKey(t)
+
+	if _, err := generatePKCS12Bundle(string(certPEM), otherKeyPEM, "password", PKCS12EncodingModern, nil); err == nil {
+		t.Error("Expected error for mismatched private key, got nil")
+	}
+}
+
+func TestValidatePrivateKey(t *testing.T) {
+	certPEM, certKeyPEM, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+	certificateContent := types.StringValue(base64.StdEncoding.EncodeToString(certBlock.Bytes))
+
+	csrKeyPEM, csrPEM, err := generateKeyAndCSR(KeyAlgorithmRSA2048, "True Tickets Signing", "")
+	if err != nil {
+		t.Fatalf("generateKeyAndCSR() error = %v", err)
+	}
+	csrContent := types.StringValue(csrPEM)
+
+	_, otherCSRPEM, err := generateKeyAndCSR(KeyAlgorithmRSA2048, "Other Signing", "")
+	if err != nil {
+		t.Fatalf("generateKeyAndCSR() error = %v", err)
+	}
+
+	tests := []struct {
+		name               string
+		privateKeyPEM      types.String
+		csrContent         types.String
+		certificateContent types.String
+		wantSummaries      []string
+	}{
+		{
+			name:               "key matches csr",
+			privateKeyPEM:      types.StringValue(csrKeyPEM),
+			csrContent:         csrContent,
+			certificateContent: types.StringNull(),
+		},
+		{
+			name:               "key does not match csr",
+			privateKeyPEM:      types.StringValue(certKeyPEM),
+			csrContent:         csrContent,
+			certificateContent: types.StringNull(),
+			wantSummaries:      []string{"Private Key Mismatch"},
+		},
+		{
+			name:               "key matches certificate",
+			privateKeyPEM:      types.StringValue(certKeyPEM),
+			csrContent:         types.StringNull(),
+			certificateContent: certificateContent,
+		},
+		{
+			name:               "key does not match certificate",
+			privateKeyPEM:      types.StringValue(csrKeyPEM),
+			csrContent:         types.StringNull(),
+			certificateContent: certificateContent,
+			wantSummaries:      []string{"Private Key Mismatch"},
+		},
+		{
+			name:               "key matches neither",
+			privateKeyPEM:      types.StringValue(csrKeyPEM),
+			csrContent:         types.StringValue(otherCSRPEM),
+			certificateContent: certificateContent,
+			wantSummaries:      []string{"Private Key Mismatch", "Private Key Mismatch"},
+		},
+		{
+			name:               "invalid key",
+			privateKeyPEM:      types.StringValue("not a private key"),
+			csrContent:         csrContent,
+			certificateContent: types.StringNull(),
+			wantSummaries:      []string{"Invalid Private Key"},
+		},
+		{
+			name:               "unknown key",
+			privateKeyPEM:      types.StringUnknown(),
+			csrContent:         csrContent,
+			certificateContent: certificateContent,
+		},
+		{
+			name:               "unknown csr",
+			privateKeyPEM:      types.StringValue(certKeyPEM),
+			csrContent:         types.StringUnknown(),
+			certificateContent: types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyPath := path.Root("private_key_pem_wo")
+			diags := validatePrivateKey(keyPath, tt.privateKeyPEM, tt.csrContent, tt.certificateContent)
+
+			if len(diags) != len(tt.wantSummaries) {
+				t.Fatalf("Got %d diagnostics, want %d: %v", len(diags), len(tt.wantSummaries), diags)
+			}
+			for i, d := range diags {
+				if d.Summary() != tt.wantSummaries[i] {
+					t.Errorf("diags[%d].Summary() = %q, want %q", i, d.Summary(), tt.wantSummaries[i])
+				}
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(keyPath) {
+					t.Errorf("diags[%d] is not an attribute diagnostic for %s", i, keyPath)
+				}
+			}
+		})
+	}
+}
+
+func TestCertificateResourceModifyPlan_KeyMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	_, otherKeyPEM, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	r := &CertificateResource{}
+
+	stateData := testCertificateResourceModel("CERT1")
+	stateData.CertificateContent = types.StringValue(base64.StdEncoding.EncodeToString(certBlock.Bytes))
+	state := testCertificateResourceState(t, stateData)
+
+	planData := stateData
+	planData.PrivateKeyPEM = types.StringValue(otherKeyPEM)
+	plan := testCertificateResourceState(t, planData)
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  state,
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected a private key mismatch error, got none")
+	}
+	if got := resp.Diagnostics.Errors()[0].Summary(); got != "Private Key Mismatch" {
+		t.Errorf("Summary = %q, want %q", got, "Private Key Mismatch")
+	}
+}
+
+func TestCertificateResourceModifyPlan_Replacement(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	_, otherKeyPEM, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	r := &CertificateResource{}
+
+	stateData := testCertificateResourceModel("CERT1")
+	stateData.CertificateContent = types.StringValue(base64.StdEncoding.EncodeToString(certBlock.Bytes))
+	state := testCertificateResourceState(t, stateData)
+
+	// A new CSR replaces the certificate, so its key must not be checked against the old one
+	planData := stateData
+	planData.CsrContent = types.StringValue("new csr")
+	planData.PrivateKeyPEM = types.StringValue(otherKeyPEM)
+	plan := testCertificateResourceState(t, planData)
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  state,
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+}
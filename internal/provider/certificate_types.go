@@ -109,3 +109,15 @@ const (
 	CertificateTypeProductionPushSSL        = "PRODUCTION_PUSH_SSL"
 	CertificateTypePushSSL                  = "PUSH_SSL"
 )
+
+// Key algorithms for locally generated private keys.
+const (
+	KeyAlgorithmRSA2048 = "RSA_2048"
+)
+
+// PKCS12 encodings for generated bundles.
+const (
+	PKCS12EncodingModern    = "modern"
+	PKCS12EncodingLegacyDES = "legacy_des"
+	PKCS12EncodingLegacyRC2 = "legacy_rc2"
+)
@@ -4,12 +4,20 @@
 package provider
 
 import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 )
 
+// oidEmailAddress is the PKCS #9 emailAddress attribute used in CSR subjects.
+var oidEmailAddress = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+
 // convertDERToPEM converts a base64 encoded DER certificate to base64 encoded PEM format.
 func convertDERToPEM(base64DER string) (string, error) {
 	// Decode the base64 encoded DER
@@ -36,8 +44,8 @@ func convertDERToPEM(base64DER string) (string, error) {
 	return base64PEM, nil
 }
 
-// extractCertificateCAIssuers parses a base64 encoded DER certificate and extracts the CA Issuers URIs.
-func extractCertificateCAIssuers(base64DER string) ([]string, error) {
+// decodeCertificate parses a base64 encoded DER certificate.
+func decodeCertificate(base64DER string) (*x509.Certificate, error) {
 	// Decode the base64 encoded DER
 	derBytes, err := base64.StdEncoding.DecodeString(base64DER)
 	if err != nil {
@@ -50,6 +58,118 @@ func extractCertificateCAIssuers(base64DER string) ([]string, error) {
 		return nil, fmt.Errorf("failed to parse X509 certificate: %w", err)
 	}
 
+	return cert, nil
+}
+
+// extractCertificateCAIssuers parses a base64 encoded DER certificate and extracts the CA Issuers URIs.
+func extractCertificateCAIssuers(base64DER string) ([]string, error) {
+	cert, err := decodeCertificate(base64DER)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return CA Issuers URIs from Authority Information Access extension
 	return cert.IssuingCertificateURL, nil
 }
+
+// parsePrivateKeyPEM parses a PEM encoded private key in PKCS #1, SEC 1 or PKCS #8 format.
+func parsePrivateKeyPEM(privateKeyPEM string) (crypto.Signer, error) {
+	keyBlock, _ := pem.Decode([]byte(privateKeyPEM))
+	if keyBlock == nil {
+		return nil, fmt.Errorf("failed to decode private key PEM")
+	}
+
+	var privateKey any
+	var err error
+	switch keyBlock.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(keyBlock.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported private key type: %s", keyBlock.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type: %T", privateKey)
+	}
+
+	return signer, nil
+}
+
+// parseCSRContent parses a PEM encoded certificate signing request.
+func parseCSRContent(csrContent string) (*x509.CertificateRequest, error) {
+	csrBlock, _ := pem.Decode([]byte(csrContent))
+	if csrBlock == nil {
+		return nil, fmt.Errorf("failed to decode CSR PEM")
+	}
+
+	csr, err := x509.ParseCertificateRequest(csrBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSR: %w", err)
+	}
+
+	return csr, nil
+}
+
+// publicKeyMatches reports whether publicKey is the public half of privateKey.
+func publicKeyMatches(privateKey crypto.Signer, publicKey crypto.PublicKey) bool {
+	pub, ok := privateKey.Public().(interface{ Equal(crypto.PublicKey) bool })
+	return ok && pub.Equal(publicKey)
+}
+
+// generateKeyAndCSR generates a private key with the given algorithm and a certificate
+// signing request for it. Both are returned PEM encoded; RSA keys use PKCS #1, matching
+// the formats accepted by generatePKCS12Bundle.
+func generateKeyAndCSR(algorithm, commonName, emailAddress string) (string, string, error) {
+	var signer crypto.Signer
+	var keyBlock *pem.Block
+
+	switch algorithm {
+	case KeyAlgorithmRSA2048:
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to generate RSA key: %w", err)
+		}
+		signer = key
+		keyBlock = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}
+	default:
+		return "", "", fmt.Errorf("unsupported key algorithm: %s", algorithm)
+	}
+
+	// Build the CSR subject the way Keychain Access does
+	subject := pkix.Name{
+		CommonName: commonName,
+	}
+	if emailAddress != "" {
+		subject.ExtraNames = append(subject.ExtraNames, pkix.AttributeTypeAndValue{
+			Type:  oidEmailAddress,
+			Value: emailAddress,
+		})
+	}
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: subject,
+	}, signer)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create certificate signing request: %w", err)
+	}
+
+	keyPEM := pem.EncodeToMemory(keyBlock)
+	csrPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE REQUEST",
+		Bytes: csrDER,
+	})
+
+	return string(keyPEM), string(csrPEM), nil
+}
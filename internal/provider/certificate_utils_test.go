@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -274,3 +275,90 @@ func createTestCertificateWithAIA(t *testing.T) *x509.Certificate {
 
 	return cert
 }
+
+func TestGenerateKeyAndCSR(t *testing.T) {
+	for _, algorithm := range []string{KeyAlgorithmRSA2048} {
+		t.Run(algorithm, func(t *testing.T) {
+			keyPEM, csrPEM, err := generateKeyAndCSR(algorithm, "True Tickets Signing", "certs@truetickets.io")
+			if err != nil {
+				t.Fatalf("generateKeyAndCSR() error = %v", err)
+			}
+
+			// Parse and verify the CSR
+			csrBlock, _ := pem.Decode([]byte(csrPEM))
+			if csrBlock == nil || csrBlock.Type != "CERTIFICATE REQUEST" {
+				t.Fatalf("Expected a CERTIFICATE REQUEST PEM block, got %q", csrPEM)
+			}
+
+			csr, err := x509.ParseCertificateRequest(csrBlock.Bytes)
+			if err != nil {
+				t.Fatalf("Failed to parse CSR: %v", err)
+			}
+			if err := csr.CheckSignature(); err != nil {
+				t.Errorf("CSR signature is invalid: %v", err)
+			}
+
+			if csr.Subject.CommonName != "True Tickets Signing" {
+				t.Errorf("CommonName = %q, want %q", csr.Subject.CommonName, "True Tickets Signing")
+			}
+
+			var email string
+			for _, name := range csr.Subject.Names {
+				if name.Type.Equal(oidEmailAddress) {
+					email, _ = name.Value.(string)
+				}
+			}
+			if email != "certs@truetickets.io" {
+				t.Errorf("emailAddress = %q, want %q", email, "certs@truetickets.io")
+			}
+
+			// The key must match the CSR and be usable for a PKCS12 bundle
+			keyBlock, _ := pem.Decode([]byte(keyPEM))
+			if keyBlock == nil {
+				t.Fatalf("Failed to decode private key PEM")
+			}
+
+			var signer crypto.Signer
+			switch algorithm {
+			case KeyAlgorithmRSA2048:
+				key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+				if err != nil {
+					t.Fatalf("Failed to parse RSA key: %v", err)
+				}
+				if key.N.BitLen() != 2048 {
+					t.Errorf("RSA key size = %d, want 2048", key.N.BitLen())
+				}
+				signer = key
+			}
+
+			if !signer.Public().(interface{ Equal(crypto.PublicKey) bool }).Equal(csr.PublicKey) {
+				t.Error("CSR public key does not match the generated private key")
+			}
+
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(1),
+				Subject:      csr.Subject,
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(time.Hour),
+			}
+			certDER, err := x509.CreateCertificate(rand.Reader, template, template, csr.PublicKey, signer)
+			if err != nil {
+				t.Fatalf("Failed to create certificate: %v", err)
+			}
+			certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+
+			if _, err := generatePKCS12Bundle(string(certPEM), keyPEM, "password", PKCS12EncodingModern, nil); err != nil {
+				t.Errorf("generatePKCS12Bundle() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestGenerateKeyAndCSR_UnsupportedAlgorithm(t *testing.T) {
+	// Apple does not accept ECDSA keys for any certificate type
+	for _, algorithm := range []string{"DSA_1024", "ECDSA_P256"} {
+		if _, _, err := generateKeyAndCSR(algorithm, "Test", ""); err == nil {
+			t.Errorf("Expected error for unsupported algorithm %s", algorithm)
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -56,6 +55,8 @@ type CertificateListItemModel struct {
 	SerialNumber          types.String `tfsdk:"serial_number"`
 	ExpirationDate        types.String `tfsdk:"expiration_date"`
 	Relationships         types.Object `tfsdk:"relationships"`
+
+	CertificateDetailsModel
 }
 
 func (d *CertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,6 +64,61 @@ func (d *CertificatesDataSource) Metadata(ctx context.Context, req datasource.Me
 }
 
 func (d *CertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Certificates include the X.509 details parsed from their content
+	certificateAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the Certificate.",
+			Computed:            true,
+		},
+		"certificate_type": schema.StringAttribute{
+			MarkdownDescription: "The type of certificate.",
+			Computed:            true,
+		},
+		"certificate_content": schema.StringAttribute{
+			MarkdownDescription: "The certificate content in base64 encoded DER format.",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"certificate_content_pem": schema.StringAttribute{
+			MarkdownDescription: "The certificate content in base64 encoded PEM format.",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "The display name of the certificate.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the certificate.",
+			Computed:            true,
+		},
+		"platform": schema.StringAttribute{
+			MarkdownDescription: "The platform for the certificate.",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "The serial number of the certificate.",
+			Computed:            true,
+		},
+		"expiration_date": schema.StringAttribute{
+			MarkdownDescription: "The expiration date of the certificate.",
+			Computed:            true,
+		},
+		"relationships": schema.SingleNestedAttribute{
+			MarkdownDescription: "The relationships for the certificate.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"pass_type_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the associated Pass Type ID.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+	for name, attribute := range certificateDetailsDataSourceAttributes() {
+		certificateAttributes[name] = attribute
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Use this data source to retrieve a list of Certificates from App Store Connect.",
 
@@ -71,56 +127,7 @@ func (d *CertificatesDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "List of certificates matching the filter criteria.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "The unique identifier of the Certificate.",
-							Computed:            true,
-						},
-						"certificate_type": schema.StringAttribute{
-							MarkdownDescription: "The type of certificate.",
-							Computed:            true,
-						},
-						"certificate_content": schema.StringAttribute{
-							MarkdownDescription: "The certificate content in base64 encoded DER format.",
-							Computed:            true,
-							Sensitive:           true,
-						},
-						"certificate_content_pem": schema.StringAttribute{
-							MarkdownDescription: "The certificate content in base64 encoded PEM format.",
-							Computed:            true,
-							Sensitive:           true,
-						},
-						"display_name": schema.StringAttribute{
-							MarkdownDescription: "The display name of the certificate.",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The name of the certificate.",
-							Computed:            true,
-						},
-						"platform": schema.StringAttribute{
-							MarkdownDescription: "The platform for the certificate.",
-							Computed:            true,
-						},
-						"serial_number": schema.StringAttribute{
-							MarkdownDescription: "The serial number of the certificate.",
-							Computed:            true,
-						},
-						"expiration_date": schema.StringAttribute{
-							MarkdownDescription: "The expiration date of the certificate.",
-							Computed:            true,
-						},
-						"relationships": schema.SingleNestedAttribute{
-							MarkdownDescription: "The relationships for the certificate.",
-							Computed:            true,
-							Attributes: map[string]schema.Attribute{
-								"pass_type_id": schema.StringAttribute{
-									MarkdownDescription: "The ID of the associated Pass Type ID.",
-									Computed:            true,
-								},
-							},
-						},
-					},
+					Attributes: certificateAttributes,
 				},
 			},
 			"filter": schema.SingleNestedAttribute{
@@ -189,7 +196,6 @@ func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	// Build query parameters
 	query := make(map[string]string)
-	query["limit"] = "200" // Maximum allowed by API
 
 	// Extract filter criteria if present
 	if !data.Filter.IsNull() {
@@ -211,9 +217,8 @@ func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		tflog.Debug(ctx, "Fetching all Certificates")
 	}
 
-	// Make the API request
-	apiResp, err := d.client.Do(ctx, Request{
-		Method:   http.MethodGet,
+	// Make the API request, following every page of results
+	apiResp, err := d.client.DoAll(ctx, Request{
 		Endpoint: "/certificates",
 		Query:    query,
 	})
@@ -289,19 +294,19 @@ func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRe
 			SerialNumber:       types.StringValue(cert.Attributes.SerialNumber),
 		}
 
-		// Convert DER to PEM format
+		// Convert DER to PEM format. One malformed certificate should not fail the whole
+		// list, so conversion and parsing failures are reported as warnings.
+		item.CertificateContentPEM = types.StringNull()
 		if cert.Attributes.CertificateContent != "" {
 			pemContent, err := convertDERToPEM(cert.Attributes.CertificateContent)
 			if err != nil {
-				resp.Diagnostics.AddError(
+				resp.Diagnostics.AddWarning(
 					"Certificate Conversion Error",
-					fmt.Sprintf("Unable to convert certificate to PEM format: %s", err),
+					fmt.Sprintf("Unable to convert certificate %s to PEM format: %s", cert.ID, err),
 				)
-				return
+			} else {
+				item.CertificateContentPEM = types.StringValue(pemContent)
 			}
-			item.CertificateContentPEM = types.StringValue(pemContent)
-		} else {
-			item.CertificateContentPEM = types.StringNull()
 		}
 
 		if cert.Attributes.ExpirationDate != nil {
@@ -332,28 +337,42 @@ func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRe
 			item.Relationships = relationshipsObj
 		}
 
+		// Parse the X.509 details of the certificate
+		details, err := parseCertificateDetails(cert.Attributes.CertificateContent)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Certificate Parsing Error",
+				fmt.Sprintf("Unable to parse the details of certificate %s, so they are left empty: %s", cert.ID, err),
+			)
+			details = nullCertificateDetails()
+		}
+		item.CertificateDetailsModel = details
+
 		certItems = append(certItems, item)
 	}
 
 	// Create the list value
-	certList, diags := types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":                      types.StringType,
-			"certificate_type":        types.StringType,
-			"certificate_content":     types.StringType,
-			"certificate_content_pem": types.StringType,
-			"display_name":            types.StringType,
-			"name":                    types.StringType,
-			"platform":                types.StringType,
-			"serial_number":           types.StringType,
-			"expiration_date":         types.StringType,
-			"relationships": types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"pass_type_id": types.StringType,
-				},
+	certAttrTypes := map[string]attr.Type{
+		"id":                      types.StringType,
+		"certificate_type":        types.StringType,
+		"certificate_content":     types.StringType,
+		"certificate_content_pem": types.StringType,
+		"display_name":            types.StringType,
+		"name":                    types.StringType,
+		"platform":                types.StringType,
+		"serial_number":           types.StringType,
+		"expiration_date":         types.StringType,
+		"relationships": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"pass_type_id": types.StringType,
 			},
 		},
-	}, certItems)
+	}
+	for name, attrType := range certificateDetailsAttrTypes {
+		certAttrTypes[name] = attrType
+	}
+
+	certList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: certAttrTypes}, certItems)
 	resp.Diagnostics.Append(diags...)
 	data.Certificates = certList
 
@@ -4,8 +4,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -88,3 +99,74 @@ data "appleappstoreconnect_certificates" "test" {
 }
 `
 }
+
+func TestCertificatesDataSourceRead_UnparseableCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"data":[`+
+			`{"type":"certificates","id":"CERT1","attributes":{"certificateType":"PASS_TYPE_ID","certificateContent":%q}},`+
+			`{"type":"certificates","id":"CERT2","attributes":{"certificateType":"PASS_TYPE_ID","certificateContent":"bm90IGEgY2VydGlmaWNhdGU="}}`+
+			`]}`, base64.StdEncoding.EncodeToString(certBlock.Bytes))
+	})
+
+	d := &CertificatesDataSource{client: client}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	// tfsdk.Config cannot be set directly, so build the raw value through a State
+	configState := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := configState.SetAttribute(ctx, path.Root("filter"), types.ObjectNull(map[string]attr.Type{
+		"certificate_type": types.StringType,
+		"display_name":     types.StringType,
+	}))
+	if diags.HasError() {
+		t.Fatalf("Failed to set config: %v", diags)
+	}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	// The unparseable certificate gets a warning instead of failing the whole list
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d: %v", resp.Diagnostics.WarningsCount(), resp.Diagnostics)
+	}
+
+	var got CertificatesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to get state: %v", resp.Diagnostics)
+	}
+
+	var items []CertificateListItemModel
+	resp.Diagnostics.Append(got.Certificates.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read certificates: %v", resp.Diagnostics)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("len(certificates) = %d, want 2", len(items))
+	}
+	if items[0].SubjectDN.ValueString() != "CN=Pass Type ID: pass.io.truetickets.test" {
+		t.Errorf("certificates[0].subject_dn = %q, want the certificate subject", items[0].SubjectDN.ValueString())
+	}
+	if !items[1].SubjectDN.IsNull() {
+		t.Errorf("certificates[1].subject_dn = %s, want null", items[1].SubjectDN)
+	}
+}
@@ -6,13 +6,19 @@ package provider
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,103 +27,376 @@ import (
 )
 
 const (
-	// baseURL is the base URL for the App Store Connect API.
-	baseURL = "https://api.appstoreconnect.apple.com/v1"
+	// defaultBaseURL is the base URL for the App Store Connect API.
+	defaultBaseURL = "https://api.appstoreconnect.apple.com/v1"
 
-	// tokenExpiration is the maximum lifetime of a JWT token (20 minutes).
-	tokenExpiration = 20 * time.Minute
+	// defaultRequestTimeout is the default time limit for a single HTTP request.
+	defaultRequestTimeout = 30 * time.Second
 
-	// tokenRefreshBuffer is the buffer time before token expiration to refresh.
+	// maxTokenLifetime is the maximum lifetime of a JWT token accepted by Apple (20 minutes),
+	// and the default lifetime.
+	maxTokenLifetime = 20 * time.Minute
+
+	// tokenRefreshBuffer is the buffer time before token expiration to refresh. Short-lived
+	// tokens are refreshed after three quarters of their lifetime instead.
 	tokenRefreshBuffer = 5 * time.Minute
+
+	// pageLimit is the page size requested when listing resources (maximum allowed by the API).
+	pageLimit = 200
+
+	// defaultMaxPages is the default maximum number of pages followed by DoAll.
+	defaultMaxPages = 50
+
+	// defaultMaxRetries is the default number of times a failed request is retried.
+	defaultMaxRetries = 3
+
+	// defaultRetryMaxWait is the default upper bound on the wait between retries.
+	defaultRetryMaxWait = 30 * time.Second
+
+	// retryMinWait is the base wait used for exponential backoff.
+	retryMinWait = 1 * time.Second
+)
+
+// Authentication modes, matching the kinds of App Store Connect API keys.
+const (
+	// AuthModeTeam authenticates with a team API key, whose tokens name the issuer.
+	AuthModeTeam = "team"
+
+	// AuthModeIndividual authenticates with an individual API key, whose tokens
+	// carry a "user" subject instead of an issuer.
+	AuthModeIndividual = "individual"
 )
 
 // Client represents an App Store Connect API client.
 type Client struct {
 	httpClient *http.Client
+	authMode   string
 	issuerID   string
 	keyID      string
-	privateKey interface{}
+	signer     TokenSigner
 	baseURL    string
+	maxPages   int
+
+	// Retry policy
+	maxRetries   int
+	retryMaxWait time.Duration
+
+	// rateLimiter throttles requests using the budget reported by Apple
+	rateLimiter *rateLimiter
 
 	// Token management
-	mu           sync.RWMutex
-	currentToken string
-	tokenExpiry  time.Time
+	tokenLifetime time.Duration
+	tokenScopes   []string
+	accessToken   string
+	mu            sync.RWMutex
+	currentToken  string
+	tokenExpiry   time.Time
 }
 
-// NewClient creates a new App Store Connect API client.
-func NewClient(issuerID, keyID, privateKeyPEM string) (*Client, error) {
-	// Validate inputs
-	if issuerID == "" {
-		return nil, fmt.Errorf("issuer ID cannot be empty")
+// ClientOption configures optional Client behavior.
+type ClientOption func(*Client)
+
+// WithMaxPages sets the maximum number of pages DoAll follows before giving up.
+// Values less than 1 are ignored.
+func WithMaxPages(maxPages int) ClientOption {
+	return func(c *Client) {
+		if maxPages > 0 {
+			c.maxPages = maxPages
+		}
 	}
-	if keyID == "" {
-		return nil, fmt.Errorf("key ID cannot be empty")
+}
+
+// WithMaxRetries sets how many times a failed request is retried. Zero disables retries.
+// Negative values are ignored.
+func WithMaxRetries(maxRetries int) ClientOption {
+	return func(c *Client) {
+		if maxRetries >= 0 {
+			c.maxRetries = maxRetries
+		}
 	}
-	if privateKeyPEM == "" {
-		return nil, fmt.Errorf("private key cannot be empty")
+}
+
+// WithRetryMaxWait sets the maximum time to wait between retries, including waits
+// requested by a Retry-After header. Values less than or equal to zero are ignored.
+func WithRetryMaxWait(maxWait time.Duration) ClientOption {
+	return func(c *Client) {
+		if maxWait > 0 {
+			c.retryMaxWait = maxWait
+		}
 	}
+}
 
-	// Parse the private key from PEM format
-	block, _ := pem.Decode([]byte(privateKeyPEM))
-	if block == nil {
-		return nil, fmt.Errorf("failed to parse private key PEM block")
+// WithRateLimitWarningThreshold sets the remaining hourly request budget below which
+// a warning is logged. Negative values are ignored.
+func WithRateLimitWarningThreshold(threshold int) ClientOption {
+	return func(c *Client) {
+		if threshold >= 0 {
+			c.rateLimiter.warningThreshold = threshold
+		}
 	}
+}
 
-	// Parse the key based on the type
-	var privateKey interface{}
-	var err error
+// WithBaseURL sets the base URL requests are sent to, including the API version path.
+// Empty values are ignored.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.baseURL = strings.TrimSuffix(baseURL, "/")
+		}
+	}
+}
 
-	switch block.Type {
-	case "PRIVATE KEY":
-		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse PKCS8 private key: %w", err)
+// WithRequestTimeout sets the time limit for a single HTTP request, including reading
+// the response body. Values less than or equal to zero are ignored.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		if timeout > 0 {
+			c.httpClient.Timeout = timeout
+		}
+	}
+}
+
+// WithTransport sets the transport used for HTTP requests. Nil values are ignored.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		if transport != nil {
+			c.httpClient.Transport = transport
 		}
-	default:
-		return nil, fmt.Errorf("unsupported private key type: %s", block.Type)
 	}
+}
+
+// WithAuthMode sets whether tokens are built for a team or an individual API key.
+// Empty values are ignored.
+func WithAuthMode(authMode string) ClientOption {
+	return func(c *Client) {
+		if authMode != "" {
+			c.authMode = authMode
+		}
+	}
+}
+
+// WithTokenLifetime sets the lifetime of generated tokens. Values less than or equal
+// to zero, or greater than the 20 minutes Apple accepts, are ignored.
+func WithTokenLifetime(lifetime time.Duration) ClientOption {
+	return func(c *Client) {
+		if lifetime > 0 && lifetime <= maxTokenLifetime {
+			c.tokenLifetime = lifetime
+		}
+	}
+}
 
-	return &Client{
+// WithTokenScopes restricts generated tokens to the given requests, such as
+// "GET /v1/certificates". Scoped tokens can only be used for those GET requests.
+func WithTokenScopes(scopes []string) ClientOption {
+	return func(c *Client) {
+		if len(scopes) > 0 {
+			c.tokenScopes = scopes
+		}
+	}
+}
+
+// WithAccessToken sets a token minted elsewhere that is sent with every request
+// instead of generated tokens. The client cannot refresh it. Empty values are ignored.
+func WithAccessToken(token string) ClientOption {
+	return func(c *Client) {
+		if token != "" {
+			c.accessToken = token
+		}
+	}
+}
+
+// WithTokenSigner sets the signer used for API tokens in place of a private key,
+// so the key can be held in a KMS or HSM. Nil values are ignored.
+func WithTokenSigner(signer TokenSigner) ClientOption {
+	return func(c *Client) {
+		if signer != nil {
+			c.signer = signer
+		}
+	}
+}
+
+// NewClient creates a new App Store Connect API client. The issuer ID is only
+// required for team API keys and is ignored for individual keys. The private key
+// may be empty when a signer is set with WithTokenSigner. None of them are used
+// when a token is supplied with WithAccessToken.
+func NewClient(issuerID, keyID, privateKeyPEM string, opts ...ClientOption) (*Client, error) {
+	client := &Client{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: defaultRequestTimeout,
 		},
-		issuerID:   issuerID,
-		keyID:      keyID,
-		privateKey: privateKey,
-		baseURL:    baseURL,
-	}, nil
+		authMode: AuthModeTeam,
+		issuerID: issuerID,
+		keyID:    keyID,
+		baseURL:  defaultBaseURL,
+		maxPages: defaultMaxPages,
+
+		tokenLifetime: maxTokenLifetime,
+
+		maxRetries:   defaultMaxRetries,
+		retryMaxWait: defaultRetryMaxWait,
+
+		rateLimiter: newRateLimiter(-1),
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if client.accessToken != "" {
+		if err := checkAccessToken(client.accessToken); err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+
+	// Validate inputs
+	if keyID == "" {
+		return nil, fmt.Errorf("key ID cannot be empty")
+	}
+
+	if client.signer == nil {
+		if privateKeyPEM == "" {
+			return nil, fmt.Errorf("private key cannot be empty")
+		}
+
+		privateKey, err := parseAPIPrivateKey([]byte(privateKeyPEM))
+		if err != nil {
+			return nil, err
+		}
+		client.signer = &privateKeyTokenSigner{key: privateKey}
+	}
+
+	switch client.authMode {
+	case AuthModeTeam:
+		if issuerID == "" {
+			return nil, fmt.Errorf("issuer ID cannot be empty")
+		}
+	case AuthModeIndividual:
+	default:
+		return nil, fmt.Errorf("unsupported auth mode: %s", client.authMode)
+	}
+
+	return client, nil
+}
+
+// parseAPIPrivateKey parses an App Store Connect API key (.p8 file). The key may be
+// PEM encoded, base64 encoded PEM, or PKCS #8 DER, either raw or base64 encoded.
+// Apple signs tokens with ES256, so only ECDSA P-256 keys are accepted.
+func parseAPIPrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	pemPrefix := []byte("-----BEGIN")
+
+	// Raw DER is binary, so only trim whitespace from textual encodings
+	der := data
+	text := bytes.TrimSpace(data)
+	if !bytes.HasPrefix(text, pemPrefix) {
+		// Keys kept in secret stores are often base64 encoded, wrapped or not
+		if decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(text)), "")); err == nil {
+			der = decoded
+			text = bytes.TrimSpace(decoded)
+		}
+	}
+
+	if bytes.HasPrefix(text, pemPrefix) {
+		block, _ := pem.Decode(text)
+		if block == nil {
+			return nil, fmt.Errorf("failed to parse private key PEM block")
+		}
+		if block.Type != "PRIVATE KEY" {
+			return nil, fmt.Errorf("unsupported private key type: %s, expected a PKCS8 \"PRIVATE KEY\" as downloaded from App Store Connect", block.Type)
+		}
+		der = block.Bytes
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PKCS8 private key: %w", err)
+	}
+
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok || ecKey.Curve != elliptic.P256() {
+		got := fmt.Sprintf("%T", key)
+		if signer, isSigner := key.(crypto.Signer); isSigner {
+			got = describePublicKey(signer.Public()) + " key"
+		}
+		return nil, fmt.Errorf("unsupported private key: App Store Connect API keys are ECDSA P-256 keys, got %s", got)
+	}
+
+	return ecKey, nil
 }
 
-// generateToken generates a new JWT token for API authentication.
-func (c *Client) generateToken() (string, error) {
+// generateToken generates a new JWT token for API authentication. ctx is passed to
+// the token signer.
+func (c *Client) generateToken(ctx context.Context) (string, error) {
 	now := time.Now()
 
 	// Create the claims
 	claims := jwt.MapClaims{
-		"iss": c.issuerID,
 		"iat": now.Unix(),
-		"exp": now.Add(tokenExpiration).Unix(),
+		"exp": now.Add(c.tokenLifetime).Unix(),
 		"aud": "appstoreconnect-v1",
 	}
 
+	if len(c.tokenScopes) > 0 {
+		claims["scope"] = c.tokenScopes
+	}
+
+	// Team keys identify the issuer; individual keys omit it and name the user as subject
+	if c.authMode == AuthModeIndividual {
+		claims["sub"] = "user"
+	} else {
+		claims["iss"] = c.issuerID
+	}
+
 	// Create the token
 	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
 	token.Header["kid"] = c.keyID
 
 	// Sign the token
-	tokenString, err := token.SignedString(c.privateKey)
+	signingString, err := token.SigningString()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	signature, err := c.signer.Sign(ctx, []byte(signingString))
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
+	if len(signature) != es256SignatureSize {
+		return "", fmt.Errorf("failed to sign token: got a %d byte signature, want %d bytes", len(signature), es256SignatureSize)
+	}
+
+	return signingString + "." + token.EncodeSegment(signature), nil
+}
+
+// checkAccessToken checks that a pre-supplied token is a JWT that has not expired.
+// Its signature cannot be verified without Apple's keys, so it is not checked.
+func checkAccessToken(token string) error {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return fmt.Errorf("failed to parse access token: %w", err)
+	}
+
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		return fmt.Errorf("failed to parse access token: %w", err)
+	}
+	if exp != nil && !time.Now().Before(exp.Time) {
+		return fmt.Errorf("access token expired at %s", exp.UTC().Format(time.RFC3339))
+	}
 
-	return tokenString, nil
+	return nil
 }
 
 // getToken returns a valid token, refreshing if necessary.
-func (c *Client) getToken() (string, error) {
+func (c *Client) getToken(ctx context.Context) (string, error) {
+	if c.accessToken != "" {
+		return c.accessToken, nil
+	}
+
+	refreshBuffer := min(tokenRefreshBuffer, c.tokenLifetime/4)
+
 	c.mu.RLock()
-	if c.currentToken != "" && time.Now().Before(c.tokenExpiry.Add(-tokenRefreshBuffer)) {
+	if c.currentToken != "" && time.Now().Before(c.tokenExpiry.Add(-refreshBuffer)) {
 		token := c.currentToken
 		c.mu.RUnlock()
 		return token, nil
@@ -129,18 +408,18 @@ func (c *Client) getToken() (string, error) {
 	defer c.mu.Unlock()
 
 	// Double-check after acquiring write lock
-	if c.currentToken != "" && time.Now().Before(c.tokenExpiry.Add(-tokenRefreshBuffer)) {
+	if c.currentToken != "" && time.Now().Before(c.tokenExpiry.Add(-refreshBuffer)) {
 		return c.currentToken, nil
 	}
 
 	// Generate new token
-	token, err := c.generateToken()
+	token, err := c.generateToken(ctx)
 	if err != nil {
 		return "", err
 	}
 
 	c.currentToken = token
-	c.tokenExpiry = time.Now().Add(tokenExpiration)
+	c.tokenExpiry = time.Now().Add(c.tokenLifetime)
 
 	return token, nil
 }
@@ -212,60 +491,166 @@ func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
 		urlStr += "?" + params.Encode()
 	}
 
-	// Marshal body if present
-	var bodyReader io.Reader
-	if req.Body != nil {
-		bodyBytes, err := json.Marshal(req.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	return c.do(ctx, req.Method, urlStr, req.Body)
+}
+
+// DoAll performs a GET request against a collection endpoint and follows
+// Links.Next until every page has been fetched. The data arrays of all pages
+// are merged into the Data field of the returned response. An error is
+// returned if more than the client's page cap would be needed.
+func (c *Client) DoAll(ctx context.Context, req Request) (*Response, error) {
+	// Request the largest page size unless the caller asked for something else
+	query := make(map[string]string, len(req.Query)+1)
+	for key, value := range req.Query {
+		query[key] = value
+	}
+	if _, ok := query["limit"]; !ok {
+		query["limit"] = strconv.Itoa(pageLimit)
+	}
+
+	req.Method = http.MethodGet
+	req.Query = query
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []json.RawMessage
+	var included []json.RawMessage
+	page := resp
+	for pages := 1; ; pages++ {
+		var pageData []json.RawMessage
+		if err := json.Unmarshal(page.Data, &pageData); err != nil {
+			return nil, fmt.Errorf("failed to parse page %d of %s: %w", pages, req.Endpoint, err)
 		}
-		bodyReader = bytes.NewReader(bodyBytes)
+		data = append(data, pageData...)
 
-		tflog.Debug(ctx, "API request body", map[string]interface{}{
-			"body": string(bodyBytes),
+		if len(page.Included) > 0 {
+			var pageIncluded []json.RawMessage
+			if err := json.Unmarshal(page.Included, &pageIncluded); err != nil {
+				return nil, fmt.Errorf("failed to parse included resources on page %d of %s: %w", pages, req.Endpoint, err)
+			}
+			included = append(included, pageIncluded...)
+		}
+
+		if page.Links.Next == "" {
+			break
+		}
+
+		if pages >= c.maxPages {
+			return nil, fmt.Errorf("listing %s exceeded the maximum of %d pages", req.Endpoint, c.maxPages)
+		}
+
+		if err := c.validateNextLink(page.Links.Next); err != nil {
+			return nil, err
+		}
+
+		tflog.Debug(ctx, "Fetching next page", map[string]interface{}{
+			"endpoint": req.Endpoint,
+			"page":     pages + 1,
 		})
+
+		page, err = c.do(ctx, http.MethodGet, page.Links.Next, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Create HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, req.Method, urlStr, bodyReader)
+	// Always return a JSON array, even when the collection is empty
+	if data == nil {
+		data = []json.RawMessage{}
+	}
+	mergedData, err := json.Marshal(data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to merge paginated data: %w", err)
 	}
+	resp.Data = mergedData
 
-	// Get token
-	token, err := c.getToken()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get authentication token: %w", err)
+	if included != nil {
+		mergedIncluded, err := json.Marshal(included)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge paginated included resources: %w", err)
+		}
+		resp.Included = mergedIncluded
 	}
 
-	// Set headers
-	httpReq.Header.Set("Authorization", "Bearer "+token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Accept", "application/json")
+	resp.Links.Next = ""
 
-	tflog.Debug(ctx, "Making API request", map[string]interface{}{
-		"method":   req.Method,
-		"endpoint": req.Endpoint,
-		"url":      urlStr,
-	})
+	return resp, nil
+}
 
-	// Perform request
-	httpResp, err := c.httpClient.Do(httpReq)
+// validateNextLink ensures a pagination link points at the configured API host,
+// so the bearer token is never sent anywhere else.
+func (c *Client) validateNextLink(next string) error {
+	nextURL, err := url.Parse(next)
 	if err != nil {
-		return nil, fmt.Errorf("failed to perform request: %w", err)
+		return fmt.Errorf("failed to parse pagination link %q: %w", next, err)
 	}
-	defer httpResp.Body.Close()
 
-	// Read response body
-	respBody, err := io.ReadAll(httpResp.Body)
+	base, err := url.Parse(c.baseURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return fmt.Errorf("failed to parse base URL: %w", err)
 	}
 
-	tflog.Debug(ctx, "API response", map[string]interface{}{
-		"status": httpResp.StatusCode,
-		"body":   string(respBody),
-	})
+	if nextURL.Scheme != base.Scheme || nextURL.Host != base.Host {
+		return fmt.Errorf("pagination link %q does not match API host %q", next, base.Host)
+	}
+
+	return nil
+}
+
+// do performs an HTTP request against a fully built URL, retrying transient
+// failures according to the client's retry policy.
+func (c *Client) do(ctx context.Context, method, urlStr string, body interface{}) (*Response, error) {
+	// Marshal body if present
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+
+		tflog.Debug(ctx, "API request body", map[string]interface{}{
+			"body": string(bodyBytes),
+		})
+	}
+
+	var httpResp *http.Response
+	var respBody []byte
+	var err error
+	for attempt := 0; ; attempt++ {
+		httpResp, respBody, err = c.send(ctx, method, urlStr, bodyBytes)
+		if attempt >= c.maxRetries || !shouldRetry(ctx, method, httpResp, err) {
+			break
+		}
+
+		wait := c.retryWait(attempt, httpResp)
+		logFields := map[string]interface{}{
+			"method":  method,
+			"url":     urlStr,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			logFields["error"] = err.Error()
+		} else {
+			logFields["status"] = httpResp.StatusCode
+		}
+		tflog.Warn(ctx, "Retrying API request", logFields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	// Parse response
 	var resp Response
@@ -276,34 +661,79 @@ func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
 			return &resp, nil
 		}
 		// For error responses that are empty, return generic error
-		return nil, fmt.Errorf("API error (status %d): empty response", httpResp.StatusCode)
+		return nil, newAPIError(httpResp, nil, "")
 	}
 
 	if err := json.Unmarshal(respBody, &resp); err != nil {
 		// If we can't parse as a standard response, check if it's an error
 		if httpResp.StatusCode >= 400 {
-			return nil, fmt.Errorf("API error (status %d): %s", httpResp.StatusCode, string(respBody))
+			return nil, newAPIError(httpResp, nil, string(respBody))
 		}
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
 	// Check for errors
-	if len(resp.Errors) > 0 {
-		// Build error message
-		var errMsg string
-		for i, apiErr := range resp.Errors {
-			if i > 0 {
-				errMsg += "; "
-			}
-			errMsg += fmt.Sprintf("%s: %s", apiErr.Title, apiErr.Detail)
-		}
-		return nil, fmt.Errorf("API error: %s", errMsg)
+	if len(resp.Errors) > 0 || httpResp.StatusCode >= 400 {
+		return nil, newAPIError(httpResp, resp.Errors, "")
+	}
+
+	return &resp, nil
+}
+
+// send performs a single HTTP request and returns the response together with its fully read body.
+func (c *Client) send(ctx context.Context, method, urlStr string, bodyBytes []byte) (*http.Response, []byte, error) {
+	var bodyReader io.Reader
+	if bodyBytes != nil {
+		bodyReader = bytes.NewReader(bodyBytes)
 	}
 
-	// Check HTTP status
-	if httpResp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: HTTP %d", httpResp.StatusCode)
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, method, urlStr, bodyReader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	return &resp, nil
+	// Wait for the shared rate limit budget before fetching the token, so a long
+	// wait cannot leave the request with a token past its refresh point
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed waiting for rate limit: %w", err)
+	}
+
+	// Get token
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get authentication token: %w", err)
+	}
+
+	// Set headers
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	tflog.Debug(ctx, "Making API request", map[string]interface{}{
+		"method": method,
+		"url":    urlStr,
+	})
+
+	// Perform request
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to perform request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	c.rateLimiter.Update(ctx, httpResp.Header.Get(rateLimitHeader))
+
+	// Read response body
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	tflog.Debug(ctx, "API response", map[string]interface{}{
+		"status": httpResp.StatusCode,
+		"body":   string(respBody),
+	})
+
+	return httpResp, respBody, nil
 }
@@ -0,0 +1,159 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APIError is returned by Client.Do when App Store Connect responds with an
+// HTTP error status. It keeps the full JSON:API errors array so callers can
+// branch on the kind of failure.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Errors contains the errors reported in the response body, if any.
+	Errors []Error
+	// Body holds the raw response body when it could not be parsed as JSON:API errors.
+	Body string
+	// RequestID is the Apple request identifier, useful when contacting Apple support.
+	RequestID string
+	// CorrelationKey is the Apple correlation key for the request.
+	CorrelationKey string
+}
+
+// newAPIError builds an APIError from an HTTP response.
+func newAPIError(httpResp *http.Response, apiErrors []Error, body string) *APIError {
+	requestID := httpResp.Header.Get("X-Apple-Request-Uuid")
+	if requestID == "" {
+		requestID = httpResp.Header.Get("X-Request-Id")
+	}
+
+	return &APIError{
+		StatusCode:     httpResp.StatusCode,
+		Errors:         apiErrors,
+		Body:           body,
+		RequestID:      requestID,
+		CorrelationKey: httpResp.Header.Get("X-Apple-Jingle-Correlation-Key"),
+	}
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var msg string
+	switch {
+	case len(e.Errors) > 0:
+		details := make([]string, 0, len(e.Errors))
+		for _, apiErr := range e.Errors {
+			details = append(details, fmt.Sprintf("%s: %s", apiErr.Title, apiErr.Detail))
+		}
+		msg = fmt.Sprintf("API error (status %d): %s", e.StatusCode, strings.Join(details, "; "))
+	case e.Body != "":
+		msg = fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Body)
+	default:
+		msg = fmt.Sprintf("API error (status %d): empty response", e.StatusCode)
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID: %s)", e.RequestID)
+	}
+
+	return msg
+}
+
+// HasCode reports whether any of the reported errors has the given code.
+func (e *APIError) HasCode(code string) bool {
+	for _, apiErr := range e.Errors {
+		if apiErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// hasStatus reports whether err is an *APIError with the given HTTP status.
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// IsNotFound reports whether err is an API error for a resource that does not exist.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an API error caused by a conflicting resource state.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an API error caused by exceeding the rate limit.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsForbidden reports whether err is an API error caused by insufficient permissions.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// addClientErrorDiagnostic adds a "Client Error" diagnostic for err. If err is an
+// *APIError whose source pointer (e.g. "/data/attributes/identifier") appears in
+// pointers, the diagnostic is attached to the corresponding attribute.
+func addClientErrorDiagnostic(diags *diag.Diagnostics, detail string, err error, pointers map[string]path.Path) {
+	detail = fmt.Sprintf("%s, got error: %s", detail, err)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		for _, e := range apiErr.Errors {
+			if e.Source == nil {
+				continue
+			}
+			if attrPath, ok := pointers[e.Source.Pointer]; ok {
+				diags.AddAttributeError(attrPath, "Client Error", detail)
+				return
+			}
+		}
+	}
+
+	diags.AddError("Client Error", detail)
+}
+
+// removeResourceIfNotFound removes a resource from state when err reports that it
+// no longer exists in App Store Connect, adding a warning that explains the drift.
+// It returns true if the resource was removed and the caller should stop reading.
+func removeResourceIfNotFound(ctx context.Context, resp *resource.ReadResponse, err error, resourceName, id string) bool {
+	if !IsNotFound(err) {
+		return false
+	}
+
+	removeMissingResource(ctx, resp, resourceName, id)
+
+	return true
+}
+
+// removeMissingResource removes a resource that no longer exists in App Store Connect
+// from state, adding a warning that explains the drift.
+func removeMissingResource(ctx context.Context, resp *resource.ReadResponse, resourceName, id string) {
+	tflog.Warn(ctx, "Resource not found, removing from state", map[string]interface{}{
+		"resource": resourceName,
+		"id":       id,
+	})
+
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("%s Not Found", resourceName),
+		fmt.Sprintf("The %s with ID %q no longer exists in App Store Connect and has been removed from Terraform state. "+
+			"It was most likely deleted outside of Terraform; the next plan will propose creating it again.", resourceName, id),
+	)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestClient_DoAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Apple-Request-Uuid", "request-123")
+		w.Header().Set("X-Apple-Jingle-Correlation-Key", "correlation-456")
+
+		switch r.URL.Path {
+		case "/v1/passTypeIds":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"errors":[{"status":"409","code":"ENTITY_ERROR.ATTRIBUTE.INVALID","title":"An attribute value is invalid.","detail":"The identifier is already in use.","source":{"pointer":"/data/attributes/identifier"}}]}`))
+		case "/v1/passTypeIds/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'passTypeIds' with id 'missing'"}]}`))
+		case "/v1/empty":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>Bad Gateway</html>`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-issuer", "test-key", testPrivateKey, WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.baseURL = server.URL + "/v1"
+
+	ctx := context.Background()
+
+	t.Run("conflict with source pointer", func(t *testing.T) {
+		_, err := client.Do(ctx, Request{Method: http.MethodPost, Endpoint: "/passTypeIds", Body: map[string]string{}})
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("Expected *APIError, got %T: %v", err, err)
+		}
+
+		if apiErr.StatusCode != http.StatusConflict {
+			t.Errorf("Expected status 409, got %d", apiErr.StatusCode)
+		}
+
+		if len(apiErr.Errors) != 1 || apiErr.Errors[0].Source == nil || apiErr.Errors[0].Source.Pointer != "/data/attributes/identifier" {
+			t.Errorf("Expected source pointer to be preserved, got %+v", apiErr.Errors)
+		}
+
+		if !apiErr.HasCode("ENTITY_ERROR.ATTRIBUTE.INVALID") {
+			t.Error("Expected HasCode to match the reported error code")
+		}
+
+		if apiErr.RequestID != "request-123" || apiErr.CorrelationKey != "correlation-456" {
+			t.Errorf("Expected request ID headers to be captured, got %q and %q", apiErr.RequestID, apiErr.CorrelationKey)
+		}
+
+		if !IsConflict(err) || IsNotFound(err) {
+			t.Error("Expected IsConflict to be true and IsNotFound to be false")
+		}
+
+		if !strings.Contains(err.Error(), "The identifier is already in use.") {
+			t.Errorf("Expected error message to contain the detail, got: %v", err)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := client.Do(ctx, Request{Method: http.MethodGet, Endpoint: "/passTypeIds/missing"})
+		if !IsNotFound(err) {
+			t.Errorf("Expected IsNotFound, got: %v", err)
+		}
+
+		// Wrapped errors are still detected
+		if !IsNotFound(fmt.Errorf("wrapped: %w", err)) {
+			t.Error("Expected IsNotFound to unwrap errors")
+		}
+	})
+
+	t.Run("empty error body", func(t *testing.T) {
+		_, err := client.Do(ctx, Request{Method: http.MethodGet, Endpoint: "/empty"})
+		if !IsForbidden(err) {
+			t.Errorf("Expected IsForbidden, got: %v", err)
+		}
+	})
+
+	t.Run("non JSON error body", func(t *testing.T) {
+		_, err := client.Do(ctx, Request{Method: http.MethodGet, Endpoint: "/gateway"})
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("Expected *APIError, got %T: %v", err, err)
+		}
+
+		if apiErr.Body != "<html>Bad Gateway</html>" {
+			t.Errorf("Expected raw body to be preserved, got %q", apiErr.Body)
+		}
+	})
+}
+
+func TestIsRateLimited(t *testing.T) {
+	if !IsRateLimited(&APIError{StatusCode: http.StatusTooManyRequests}) {
+		t.Error("Expected IsRateLimited for status 429")
+	}
+
+	if IsRateLimited(errors.New("plain error")) {
+		t.Error("Expected IsRateLimited to be false for non-API errors")
+	}
+}
+
+func TestAddClientErrorDiagnostic(t *testing.T) {
+	pointers := map[string]path.Path{
+		"/data/attributes/identifier": path.Root("identifier"),
+	}
+
+	t.Run("maps source pointer to attribute", func(t *testing.T) {
+		var diags diag.Diagnostics
+		err := &APIError{
+			StatusCode: http.StatusConflict,
+			Errors: []Error{
+				{Title: "Conflict", Detail: "No pointer"},
+				{Title: "Invalid", Detail: "Bad identifier", Source: &ErrorSource{Pointer: "/data/attributes/identifier"}},
+			},
+		}
+
+		addClientErrorDiagnostic(&diags, "Unable to create Pass Type ID", err, pointers)
+
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("Expected 1 error, got %d", diags.ErrorsCount())
+		}
+
+		withPath, ok := diags[0].(diag.DiagnosticWithPath)
+		if !ok {
+			t.Fatal("Expected an attribute diagnostic")
+		}
+
+		if !withPath.Path().Equal(path.Root("identifier")) {
+			t.Errorf("Expected path identifier, got %s", withPath.Path())
+		}
+	})
+
+	t.Run("falls back to resource level error", func(t *testing.T) {
+		var diags diag.Diagnostics
+		addClientErrorDiagnostic(&diags, "Unable to create Pass Type ID", errors.New("connection refused"), pointers)
+
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("Expected 1 error, got %d", diags.ErrorsCount())
+		}
+
+		if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+			t.Error("Expected a resource level diagnostic")
+		}
+
+		if !strings.Contains(diags[0].Detail(), "Unable to create Pass Type ID, got error: connection refused") {
+			t.Errorf("Unexpected detail: %s", diags[0].Detail())
+		}
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// rateLimitHeader is the response header carrying the hourly request budget.
+	rateLimitHeader = "X-Rate-Limit"
+
+	// rateLimitWindow is the window over which Apple's request budget is replenished.
+	rateLimitWindow = time.Hour
+
+	// defaultRateLimitWarningPercent is the share of the hourly limit below which a
+	// warning is logged when no explicit threshold is configured.
+	defaultRateLimitWarningPercent = 10
+)
+
+// rateLimitInfo is the parsed content of an X-Rate-Limit header, for example
+// "user-hour-lim:3500;user-hour-rem:3499;".
+type rateLimitInfo struct {
+	Limit     int
+	Remaining int
+}
+
+// parseRateLimitHeader parses an X-Rate-Limit header value. It returns false if
+// either the limit or the remaining budget is missing.
+func parseRateLimitHeader(value string) (rateLimitInfo, bool) {
+	info := rateLimitInfo{Limit: -1, Remaining: -1}
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			continue
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil || n < 0 {
+			continue
+		}
+
+		switch strings.TrimSpace(key) {
+		case "user-hour-lim":
+			info.Limit = n
+		case "user-hour-rem":
+			info.Remaining = n
+		}
+	}
+
+	if info.Limit <= 0 || info.Remaining < 0 {
+		return rateLimitInfo{}, false
+	}
+
+	return info, true
+}
+
+// rateLimiter is a token bucket shared by every request made through a Client.
+// The bucket holds the remaining hourly budget reported by Apple and refills at
+// the hourly limit spread evenly over the hour. Until the first X-Rate-Limit
+// header is seen requests are not throttled.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// known is true once a rate limit header has been observed.
+	known bool
+	// limit is the hourly request limit.
+	limit int
+	// tokens is the current budget; it goes negative while requests are queued.
+	tokens float64
+	// last is the time tokens was last refilled.
+	last time.Time
+
+	// warningThreshold is the remaining budget below which a warning is logged.
+	// A negative value means the default percentage of the limit is used.
+	warningThreshold int
+	// warned suppresses repeated warnings until the budget recovers.
+	warned bool
+
+	now func() time.Time
+}
+
+// newRateLimiter creates a rate limiter with the given warning threshold.
+func newRateLimiter(warningThreshold int) *rateLimiter {
+	return &rateLimiter{
+		warningThreshold: warningThreshold,
+		now:              time.Now,
+	}
+}
+
+// refillRate returns the number of tokens added per second.
+func (l *rateLimiter) refillRate() float64 {
+	return float64(l.limit) / rateLimitWindow.Seconds()
+}
+
+// refill adds the tokens accrued since the last refill. Callers must hold l.mu.
+func (l *rateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed > 0 {
+		l.tokens = math.Min(float64(l.limit), l.tokens+elapsed*l.refillRate())
+	}
+	l.last = now
+}
+
+// Wait reserves one token, blocking until it becomes available or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	if !l.known {
+		l.mu.Unlock()
+		return nil
+	}
+
+	now := l.now()
+	l.refill(now)
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.refillRate() * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, "Throttling API request to stay within the App Store Connect rate limit", map[string]interface{}{
+		"wait": wait.String(),
+	})
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Return the reserved token so queued requests are not delayed further
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Update synchronizes the bucket with an X-Rate-Limit header value and logs a
+// warning when the remaining budget drops below the warning threshold.
+func (l *rateLimiter) Update(ctx context.Context, header string) {
+	info, ok := parseRateLimitHeader(header)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.known {
+		l.refill(now)
+	} else {
+		l.tokens = float64(info.Remaining)
+		l.last = now
+	}
+	l.known = true
+	l.limit = info.Limit
+
+	// Apple's count is authoritative, but requests reserved locally and still in
+	// flight have not been counted by Apple yet, so never raise the budget here.
+	if remaining := float64(info.Remaining); remaining < l.tokens {
+		l.tokens = remaining
+	}
+
+	threshold := l.warningThreshold
+	if threshold < 0 {
+		threshold = info.Limit * defaultRateLimitWarningPercent / 100
+	}
+
+	if info.Remaining < threshold {
+		if !l.warned {
+			tflog.Warn(ctx, "App Store Connect API rate limit budget is running low", map[string]interface{}{
+				"limit":     info.Limit,
+				"remaining": info.Remaining,
+				"threshold": threshold,
+			})
+			l.warned = true
+		}
+	} else {
+		l.warned = false
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimitHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   rateLimitInfo
+		wantOK bool
+	}{
+		{
+			name:   "standard header",
+			value:  "user-hour-lim:3500;user-hour-rem:3499;",
+			want:   rateLimitInfo{Limit: 3500, Remaining: 3499},
+			wantOK: true,
+		},
+		{
+			name:   "reordered with whitespace",
+			value:  " user-hour-rem: 10 ; user-hour-lim: 3500",
+			want:   rateLimitInfo{Limit: 3500, Remaining: 10},
+			wantOK: true,
+		},
+		{
+			name:   "exhausted",
+			value:  "user-hour-lim:3500;user-hour-rem:0;",
+			want:   rateLimitInfo{Limit: 3500, Remaining: 0},
+			wantOK: true,
+		},
+		{
+			name:   "missing remaining",
+			value:  "user-hour-lim:3500;",
+			wantOK: false,
+		},
+		{
+			name:   "invalid numbers",
+			value:  "user-hour-lim:abc;user-hour-rem:def;",
+			wantOK: false,
+		},
+		{
+			name:   "empty",
+			value:  "",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRateLimitHeader(tt.value)
+			if ok != tt.wantOK {
+				t.Fatalf("parseRateLimitHeader(%q) ok = %v, want %v", tt.value, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("parseRateLimitHeader(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no throttling before first header", func(t *testing.T) {
+		limiter := newRateLimiter(-1)
+		for i := 0; i < 100; i++ {
+			if err := limiter.Wait(ctx); err != nil {
+				t.Fatalf("Wait() returned error: %v", err)
+			}
+		}
+	})
+
+	t.Run("consumes remaining budget without waiting", func(t *testing.T) {
+		now := time.Now()
+		limiter := newRateLimiter(-1)
+		limiter.now = func() time.Time { return now }
+		limiter.Update(ctx, "user-hour-lim:3600;user-hour-rem:3;")
+
+		for i := 0; i < 3; i++ {
+			if err := limiter.Wait(ctx); err != nil {
+				t.Fatalf("Wait() returned error: %v", err)
+			}
+		}
+
+		// The budget is now exhausted, so the next request must wait for a refill
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		if err := limiter.Wait(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected deadline exceeded while throttled, got: %v", err)
+		}
+	})
+
+	t.Run("refills over time", func(t *testing.T) {
+		now := time.Now()
+		limiter := newRateLimiter(-1)
+		limiter.now = func() time.Time { return now }
+		limiter.Update(ctx, "user-hour-lim:3600;user-hour-rem:0;")
+
+		// 3600 requests per hour refill one token per second
+		now = now.Add(2 * time.Second)
+
+		for i := 0; i < 2; i++ {
+			if err := limiter.Wait(ctx); err != nil {
+				t.Fatalf("Wait() returned error: %v", err)
+			}
+		}
+
+		if limiter.tokens > 0.001 || limiter.tokens < -0.001 {
+			t.Errorf("Expected bucket to be empty, got %v tokens", limiter.tokens)
+		}
+	})
+
+	t.Run("header never raises budget above local reservations", func(t *testing.T) {
+		now := time.Now()
+		limiter := newRateLimiter(-1)
+		limiter.now = func() time.Time { return now }
+		limiter.Update(ctx, "user-hour-lim:3500;user-hour-rem:100;")
+
+		_ = limiter.Wait(ctx)
+		_ = limiter.Wait(ctx)
+		limiter.Update(ctx, "user-hour-lim:3500;user-hour-rem:99;")
+
+		if limiter.tokens != 98 {
+			t.Errorf("Expected 98 tokens, got %v", limiter.tokens)
+		}
+	})
+
+	t.Run("warning threshold", func(t *testing.T) {
+		limiter := newRateLimiter(-1)
+
+		limiter.Update(ctx, "user-hour-lim:3500;user-hour-rem:1000;")
+		if limiter.warned {
+			t.Error("Expected no warning above default threshold")
+		}
+
+		limiter.Update(ctx, "user-hour-lim:3500;user-hour-rem:349;")
+		if !limiter.warned {
+			t.Error("Expected warning below default threshold of 10%")
+		}
+
+		limiter.warningThreshold = 10
+		limiter.Update(ctx, "user-hour-lim:3500;user-hour-rem:349;")
+		if limiter.warned {
+			t.Error("Expected warning to reset above configured threshold")
+		}
+	})
+}
+
+func TestClient_DoRateLimitHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit", "user-hour-lim:3500;user-hour-rem:42;")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-issuer", "test-key", testPrivateKey, WithRateLimitWarningThreshold(50))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.baseURL = server.URL + "/v1"
+
+	if _, err := client.Do(context.Background(), Request{Method: http.MethodGet, Endpoint: "/passTypeIds"}); err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+
+	client.rateLimiter.mu.Lock()
+	defer client.rateLimiter.mu.Unlock()
+
+	if !client.rateLimiter.known {
+		t.Fatal("Expected rate limiter to record the X-Rate-Limit header")
+	}
+
+	if client.rateLimiter.limit != 3500 {
+		t.Errorf("Expected limit 3500, got %d", client.rateLimiter.limit)
+	}
+
+	if client.rateLimiter.tokens > 42 {
+		t.Errorf("Expected at most 42 tokens, got %v", client.rateLimiter.tokens)
+	}
+
+	if !client.rateLimiter.warned {
+		t.Error("Expected warning below configured threshold")
+	}
+}
+
+// signerFunc adapts a function to the TokenSigner interface.
+type signerFunc func(ctx context.Context, signingInput []byte) ([]byte, error)
+
+// Sign implements TokenSigner.
+func (f signerFunc) Sign(ctx context.Context, signingInput []byte) ([]byte, error) {
+	return f(ctx, signingInput)
+}
+
+func TestClient_DoThrottledTokenMintedAfterWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	var signedAt time.Time
+	signer := signerFunc(func(ctx context.Context, signingInput []byte) ([]byte, error) {
+		signedAt = time.Now()
+		return make([]byte, es256SignatureSize), nil
+	})
+
+	client, err := NewClient("test-issuer", "test-key", "", WithTokenSigner(signer), WithBaseURL(server.URL+"/v1"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// An exhausted budget refilling at 10 requests per second forces a ~100ms wait
+	client.rateLimiter.known = true
+	client.rateLimiter.limit = 36000
+	client.rateLimiter.tokens = 0
+	client.rateLimiter.last = time.Now()
+
+	start := time.Now()
+	if _, err := client.Do(context.Background(), Request{Method: http.MethodGet, Endpoint: "/passTypeIds"}); err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+
+	if waited := signedAt.Sub(start); waited < 50*time.Millisecond {
+		t.Errorf("Token was minted %s after the request started, want after the rate limit wait", waited)
+	}
+}
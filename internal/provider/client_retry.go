@@ -0,0 +1,95 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isIdempotentMethod reports whether a request with the given method can be
+// safely repeated after an ambiguous failure.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry decides whether a request should be retried based on its outcome.
+// Rate limited (429) responses are retried for every method because Apple rejects
+// them before processing. Server errors and transport failures are only retried for
+// idempotent methods, since a POST or PATCH may already have been applied.
+func shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	// Never retry once the caller has given up
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotentMethod(method)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotentMethod(method)
+	default:
+		return false
+	}
+}
+
+// retryWait returns how long to wait before the next attempt. A Retry-After header
+// takes precedence; otherwise exponential backoff with jitter is used. The result
+// never exceeds the client's maximum retry wait.
+func (c *Client) retryWait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(wait, c.retryMaxWait)
+		}
+	}
+
+	// Exponential backoff: retryMinWait * 2^attempt, capped at retryMaxWait
+	backoff := c.retryMaxWait
+	if attempt < 32 {
+		backoff = min(retryMinWait<<attempt, c.retryMaxWait)
+	}
+
+	// Equal jitter: keep half of the backoff and randomize the other half
+	half := backoff / 2
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter parses a Retry-After header value given either as a number of
+// seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
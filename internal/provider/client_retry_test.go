@@ -0,0 +1,183 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{name: "GET rate limited", method: http.MethodGet, status: http.StatusTooManyRequests, want: true},
+		{name: "POST rate limited", method: http.MethodPost, status: http.StatusTooManyRequests, want: true},
+		{name: "GET server error", method: http.MethodGet, status: http.StatusServiceUnavailable, want: true},
+		{name: "DELETE server error", method: http.MethodDelete, status: http.StatusInternalServerError, want: true},
+		{name: "POST server error", method: http.MethodPost, status: http.StatusBadGateway, want: false},
+		{name: "PATCH server error", method: http.MethodPatch, status: http.StatusBadGateway, want: false},
+		{name: "GET not implemented", method: http.MethodGet, status: http.StatusNotImplemented, want: false},
+		{name: "GET client error", method: http.MethodGet, status: http.StatusConflict, want: false},
+		{name: "GET success", method: http.MethodGet, status: http.StatusOK, want: false},
+		{name: "GET transport error", method: http.MethodGet, err: errors.New("connection reset by peer"), want: true},
+		{name: "POST transport error", method: http.MethodPost, err: errors.New("connection reset by peer"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status}
+			}
+
+			if got := shouldRetry(ctx, tt.method, resp, tt.err); got != tt.want {
+				t.Errorf("shouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("cancelled context", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		if shouldRetry(cancelled, http.MethodGet, &http.Response{StatusCode: http.StatusTooManyRequests}, nil) {
+			t.Error("Expected no retry after context cancellation")
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "seconds", value: "120", want: 2 * time.Minute, wantOK: true},
+		{name: "zero seconds", value: "0", want: 0, wantOK: true},
+		{name: "http date", value: "Wed, 01 Jan 2025 12:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		{name: "http date in the past", value: "Wed, 01 Jan 2025 11:00:00 GMT", want: 0, wantOK: true},
+		{name: "empty", value: "", wantOK: false},
+		{name: "negative", value: "-5", wantOK: false},
+		{name: "garbage", value: "soon", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value, now)
+			if ok != tt.wantOK {
+				t.Fatalf("parseRetryAfter(%q) ok = %v, want %v", tt.value, ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_RetryWait(t *testing.T) {
+	client := &Client{retryMaxWait: 10 * time.Second}
+
+	t.Run("exponential backoff with jitter", func(t *testing.T) {
+		for attempt := 0; attempt < 6; attempt++ {
+			backoff := min(retryMinWait<<attempt, client.retryMaxWait)
+			wait := client.retryWait(attempt, nil)
+			if wait < backoff/2 || wait > backoff {
+				t.Errorf("attempt %d: wait %v outside [%v, %v]", attempt, wait, backoff/2, backoff)
+			}
+		}
+	})
+
+	t.Run("retry after header", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+		if wait := client.retryWait(0, resp); wait != 3*time.Second {
+			t.Errorf("Expected 3s wait, got %v", wait)
+		}
+	})
+
+	t.Run("retry after capped at max wait", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+		if wait := client.retryWait(0, resp); wait != client.retryMaxWait {
+			t.Errorf("Expected wait capped at %v, got %v", client.retryMaxWait, wait)
+		}
+	})
+}
+
+func TestClient_DoRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"type":"passTypeIds","id":"test-id"}}`))
+	}))
+	defer server.Close()
+
+	newTestClient := func(t *testing.T, opts ...ClientOption) *Client {
+		opts = append([]ClientOption{WithRetryMaxWait(10 * time.Millisecond)}, opts...)
+		client, err := NewClient("test-issuer", "test-key", testPrivateKey, opts...)
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		client.baseURL = server.URL + "/v1"
+		return client
+	}
+
+	t.Run("succeeds after retries", func(t *testing.T) {
+		attempts.Store(0)
+		client := newTestClient(t)
+
+		if _, err := client.Do(context.Background(), Request{Method: http.MethodGet, Endpoint: "/passTypeIds/test-id"}); err != nil {
+			t.Fatalf("Expected request to succeed after retries, got: %v", err)
+		}
+
+		if got := attempts.Load(); got != 3 {
+			t.Errorf("Expected 3 attempts, got %d", got)
+		}
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		attempts.Store(0)
+		client := newTestClient(t, WithMaxRetries(1))
+
+		if _, err := client.Do(context.Background(), Request{Method: http.MethodGet, Endpoint: "/passTypeIds/test-id"}); err == nil {
+			t.Fatal("Expected error after exhausting retries, got nil")
+		}
+
+		if got := attempts.Load(); got != 2 {
+			t.Errorf("Expected 2 attempts, got %d", got)
+		}
+	})
+
+	t.Run("retries disabled", func(t *testing.T) {
+		attempts.Store(0)
+		client := newTestClient(t, WithMaxRetries(0))
+
+		if _, err := client.Do(context.Background(), Request{Method: http.MethodGet, Endpoint: "/passTypeIds/test-id"}); err == nil {
+			t.Fatal("Expected error with retries disabled, got nil")
+		}
+
+		if got := attempts.Load(); got != 1 {
+			t.Errorf("Expected 1 attempt, got %d", got)
+		}
+	})
+}
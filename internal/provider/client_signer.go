@@ -0,0 +1,152 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// es256SignatureSize is the size of an ES256 JWS signature: R and S as 32 byte
+	// big-endian integers.
+	es256SignatureSize = 64
+
+	// defaultSigningCommandTimeout bounds how long a signing command may run.
+	defaultSigningCommandTimeout = 30 * time.Second
+)
+
+// TokenSigner signs App Store Connect API tokens. Implementations let the API key
+// live outside the provider, such as in a KMS or HSM.
+type TokenSigner interface {
+	// Sign returns the ES256 signature of signingInput, the JWS signing input
+	// "<header>.<claims>", as the 64 byte concatenation of R and S. ctx is the
+	// context of the API request that needs the token; remote signers should
+	// give up when it is canceled.
+	Sign(ctx context.Context, signingInput []byte) ([]byte, error)
+}
+
+// privateKeyTokenSigner signs tokens with an API key held in memory.
+type privateKeyTokenSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+// Sign implements TokenSigner.
+func (s *privateKeyTokenSigner) Sign(ctx context.Context, signingInput []byte) ([]byte, error) {
+	return jwt.SigningMethodES256.Sign(string(signingInput), s.key)
+}
+
+// CommandTokenSigner signs tokens by running an external command. The command
+// receives the JWS signing input on stdin and writes the ES256 signature to
+// stdout, either as the raw 64 byte R || S value or as an ASN.1 DER signature
+// (as produced by `openssl dgst -sha256 -sign` and most KMS APIs), optionally
+// base64 encoded.
+type CommandTokenSigner struct {
+	command []string
+	timeout time.Duration
+}
+
+// NewCommandTokenSigner creates a signer that runs command, an executable followed
+// by its arguments.
+func NewCommandTokenSigner(command []string) (*CommandTokenSigner, error) {
+	if len(command) == 0 || command[0] == "" {
+		return nil, fmt.Errorf("signing command cannot be empty")
+	}
+
+	return &CommandTokenSigner{
+		command: command,
+		timeout: defaultSigningCommandTimeout,
+	}, nil
+}
+
+// Sign implements TokenSigner.
+func (s *CommandTokenSigner) Sign(ctx context.Context, signingInput []byte) ([]byte, error) {
+	runCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(runCtx, s.command[0], s.command[1:]...)
+	cmd.Stdin = bytes.NewReader(signingInput)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Don't wait forever for children of a killed command that hold stdout open
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("signing command %s stopped: %w", s.command[0], err)
+		}
+		if runCtx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("signing command %s timed out after %s", s.command[0], s.timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("signing command %s failed: %w: %s", s.command[0], err, msg)
+		}
+		return nil, fmt.Errorf("signing command %s failed: %w", s.command[0], err)
+	}
+
+	signature, err := decodeES256Signature(stdout.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("signing command %s returned an invalid signature: %w", s.command[0], err)
+	}
+
+	return signature, nil
+}
+
+// decodeES256Signature converts a signature returned by a signing command to the
+// raw R || S form used by JWS.
+func decodeES256Signature(output []byte) ([]byte, error) {
+	if signature, ok := rawES256Signature(output); ok {
+		return signature, nil
+	}
+
+	// Binary output is never valid base64, so this only matches textual output
+	text := strings.Join(strings.Fields(string(output)), "")
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(text); err == nil {
+			if signature, ok := rawES256Signature(decoded); ok {
+				return signature, nil
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, fmt.Errorf("no output")
+	}
+	return nil, fmt.Errorf("expected a 64 byte R || S or ASN.1 DER ECDSA P-256 signature, optionally base64 encoded")
+}
+
+// rawES256Signature returns data as a raw R || S signature if it is one, or
+// converts it from an ASN.1 DER ECDSA signature.
+func rawES256Signature(data []byte) ([]byte, bool) {
+	if len(data) == es256SignatureSize {
+		return data, true
+	}
+
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(data, &sig)
+	if err != nil || len(rest) != 0 || sig.R == nil || sig.S == nil {
+		return nil, false
+	}
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.BitLen() > 256 || sig.S.BitLen() > 256 {
+		return nil, false
+	}
+
+	signature := make([]byte, es256SignatureSize)
+	sig.R.FillBytes(signature[:es256SignatureSize/2])
+	sig.S.FillBytes(signature[es256SignatureSize/2:])
+	return signature, true
+}
@@ -0,0 +1,263 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// writeSigningScript writes an executable shell script to a temporary directory.
+func writeSigningScript(t *testing.T, body string) string {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Signing command tests use shell scripts")
+	}
+
+	script := filepath.Join(t.TempDir(), "sign.sh")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\n"+body+"\n"), 0o755); err != nil {
+		t.Fatalf("Failed to write script: %v", err)
+	}
+	return script
+}
+
+func TestDecodeES256Signature(t *testing.T) {
+	key, err := parseAPIPrivateKey([]byte(testPrivateKey))
+	if err != nil {
+		t.Fatalf("Failed to parse test key: %v", err)
+	}
+
+	digest := sha256.Sum256([]byte("header.claims"))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+	der, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+
+	raw := make([]byte, es256SignatureSize)
+	r.FillBytes(raw[:32])
+	s.FillBytes(raw[32:])
+
+	tests := []struct {
+		name    string
+		output  []byte
+		wantErr bool
+	}{
+		{name: "raw", output: raw},
+		{name: "der", output: der},
+		{name: "base64 der", output: []byte(base64.StdEncoding.EncodeToString(der) + "\n")},
+		{name: "base64url raw", output: []byte(base64.RawURLEncoding.EncodeToString(raw))},
+		{name: "empty", output: nil, wantErr: true},
+		{name: "text", output: []byte("signed!\n"), wantErr: true},
+		{name: "short", output: raw[:32], wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signature, err := decodeES256Signature(tt.output)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeES256Signature() error = %v", err)
+			}
+
+			gotR := new(big.Int).SetBytes(signature[:32])
+			gotS := new(big.Int).SetBytes(signature[32:])
+			if !ecdsa.Verify(&key.PublicKey, digest[:], gotR, gotS) {
+				t.Error("Decoded signature does not verify")
+			}
+		})
+	}
+}
+
+func TestNewCommandTokenSigner_Empty(t *testing.T) {
+	if _, err := NewCommandTokenSigner(nil); err == nil {
+		t.Error("Expected error for empty command, got nil")
+	}
+	if _, err := NewCommandTokenSigner([]string{""}); err == nil {
+		t.Error("Expected error for empty executable, got nil")
+	}
+}
+
+func TestCommandTokenSigner_OpenSSL(t *testing.T) {
+	if _, err := exec.LookPath("openssl"); err != nil {
+		t.Skip("openssl is not installed")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+
+	keyFile := filepath.Join(t.TempDir(), "AuthKey.p8")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("Failed to write key: %v", err)
+	}
+
+	// Stands in for a KMS or HSM client: signs stdin and prints a DER signature
+	script := writeSigningScript(t, `exec openssl dgst -sha256 -sign "$1"`)
+
+	signer, err := NewCommandTokenSigner([]string{script, keyFile})
+	if err != nil {
+		t.Fatalf("NewCommandTokenSigner() error = %v", err)
+	}
+
+	client, err := NewClient("test-issuer", "test-key", "", WithTokenSigner(signer))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	tokenString, err := client.generateToken(context.Background())
+	if err != nil {
+		t.Fatalf("generateToken() error = %v", err)
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}))
+	if err != nil {
+		t.Fatalf("Failed to verify token: %v", err)
+	}
+	if token.Header["kid"] != "test-key" {
+		t.Errorf("kid = %v, want test-key", token.Header["kid"])
+	}
+}
+
+func TestCommandTokenSigner_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		timeout time.Duration
+		wantErr string
+	}{
+		{
+			name:    "command fails",
+			script:  `echo "key alias/asc not found" >&2; exit 3`,
+			wantErr: "key alias/asc not found",
+		},
+		{
+			name:    "invalid signature",
+			script:  `echo "not a signature"`,
+			wantErr: "returned an invalid signature",
+		},
+		{
+			name:    "no output",
+			script:  `cat >/dev/null`,
+			wantErr: "no output",
+		},
+		{
+			name:    "timeout",
+			script:  `exec sleep 5`,
+			timeout: 100 * time.Millisecond,
+			wantErr: "timed out",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewCommandTokenSigner([]string{writeSigningScript(t, tt.script)})
+			if err != nil {
+				t.Fatalf("NewCommandTokenSigner() error = %v", err)
+			}
+			if tt.timeout > 0 {
+				signer.timeout = tt.timeout
+			}
+
+			_, err = signer.Sign(context.Background(), []byte("header.claims"))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Sign() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommandTokenSigner_Canceled(t *testing.T) {
+	signer, err := NewCommandTokenSigner([]string{writeSigningScript(t, `exec sleep 5`)})
+	if err != nil {
+		t.Fatalf("NewCommandTokenSigner() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// The request context stops the command long before the signer's own timeout
+	start := time.Now()
+	_, err = signer.Sign(ctx, []byte("header.claims"))
+	if err == nil || !strings.Contains(err.Error(), "stopped") {
+		t.Errorf("Sign() error = %v, want error containing %q", err, "stopped")
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("Sign() returned after %s, want it to stop with the context", elapsed)
+	}
+}
+
+func TestClient_TokenSignerRequestContext(t *testing.T) {
+	type contextKey struct{}
+
+	var got any
+	signer := signerFunc(func(ctx context.Context, signingInput []byte) ([]byte, error) {
+		got = ctx.Value(contextKey{})
+		return make([]byte, es256SignatureSize), nil
+	})
+
+	client, err := NewClient("test-issuer", "test-key", "", WithTokenSigner(signer))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "request")
+	if _, err := client.getToken(ctx); err != nil {
+		t.Fatalf("getToken() error = %v", err)
+	}
+	if got != "request" {
+		t.Errorf("Signer received context value %v, want the request context", got)
+	}
+}
+
+func TestNewClient_TokenSigner(t *testing.T) {
+	signer, err := NewCommandTokenSigner([]string{"asc-sign"})
+	if err != nil {
+		t.Fatalf("NewCommandTokenSigner() error = %v", err)
+	}
+
+	client, err := NewClient("test-issuer", "test-key", "", WithTokenSigner(signer))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.signer != signer {
+		t.Error("NewClient() did not use the configured signer")
+	}
+
+	if _, err := NewClient("test-issuer", "test-key", ""); err == nil {
+		t.Error("Expected error without a private key or signer, got nil")
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportConfig describes how the client connects to the App Store Connect API.
+type TransportConfig struct {
+	// ProxyURL routes requests through an HTTP(S) proxy. When nil, the
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used.
+	ProxyURL *url.URL
+
+	// RootCAs replaces the system roots used to verify the API's certificate.
+	// Build it with newCertPool to trust a custom CA bundle in addition to them.
+	RootCAs *x509.CertPool
+
+	// InsecureSkipVerify disables TLS certificate verification. Only for testing
+	// against a local stand-in of the API.
+	InsecureSkipVerify bool
+}
+
+// IsZero reports whether the configuration leaves the default transport unchanged.
+func (tc TransportConfig) IsZero() bool {
+	return tc.ProxyURL == nil && tc.RootCAs == nil && !tc.InsecureSkipVerify
+}
+
+// newHTTPTransport builds an HTTP transport from the default transport and tc.
+func newHTTPTransport(tc TransportConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if tc.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(tc.ProxyURL)
+	}
+
+	if tc.RootCAs != nil || tc.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			RootCAs:            tc.RootCAs,
+			InsecureSkipVerify: tc.InsecureSkipVerify,
+		}
+	}
+
+	return transport
+}
+
+// parseHTTPURL parses an absolute http or https URL, such as the API endpoint or a proxy.
+func parseHTTPURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%q must be an absolute http or https URL", rawURL)
+	}
+	return u, nil
+}
+
+// newCertPool returns the system roots extended with the PEM encoded certificates in caBundlePEM.
+func newCertPool(caBundlePEM []byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(caBundlePEM) {
+		return nil, fmt.Errorf("no PEM encoded certificates found")
+	}
+	return pool, nil
+}
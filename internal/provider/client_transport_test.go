@@ -0,0 +1,150 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientOptions_BaseURLAndTimeout(t *testing.T) {
+	client, err := NewClient("test-issuer", "test-key", testPrivateKey,
+		WithBaseURL("http://localhost:8080/v1/"),
+		WithRequestTimeout(5*time.Second),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if client.baseURL != "http://localhost:8080/v1" {
+		t.Errorf("baseURL = %q, want %q", client.baseURL, "http://localhost:8080/v1")
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %s, want 5s", client.httpClient.Timeout)
+	}
+
+	defaults, err := NewClient("test-issuer", "test-key", testPrivateKey, WithBaseURL(""), WithRequestTimeout(0))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if defaults.baseURL != defaultBaseURL {
+		t.Errorf("baseURL = %q, want %q", defaults.baseURL, defaultBaseURL)
+	}
+	if defaults.httpClient.Timeout != defaultRequestTimeout {
+		t.Errorf("Timeout = %s, want %s", defaults.httpClient.Timeout, defaultRequestTimeout)
+	}
+}
+
+func TestNewHTTPTransport_Proxy(t *testing.T) {
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		_, _ = w.Write([]byte(`{"data":{"type":"passTypeIds","id":"P1"}}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	proxyURL, err := parseHTTPURL(proxy.URL)
+	if err != nil {
+		t.Fatalf("parseHTTPURL() error = %v", err)
+	}
+
+	client, err := NewClient("test-issuer", "test-key", testPrivateKey,
+		WithBaseURL("http://api.appstoreconnect.test/v1"),
+		WithTransport(newHTTPTransport(TransportConfig{ProxyURL: proxyURL})),
+		WithMaxRetries(0),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.Do(context.Background(), Request{Method: http.MethodGet, Endpoint: "/passTypeIds/P1"}); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if proxiedURL != "http://api.appstoreconnect.test/v1/passTypeIds/P1" {
+		t.Errorf("Proxy received %q, want the absolute API URL", proxiedURL)
+	}
+}
+
+func TestNewHTTPTransport_TLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"type":"passTypeIds","id":"P1"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	rootCAs, err := newCertPool(caBundle)
+	if err != nil {
+		t.Fatalf("newCertPool() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		config  TransportConfig
+		wantErr bool
+	}{
+		{
+			name:    "untrusted certificate",
+			config:  TransportConfig{},
+			wantErr: true,
+		},
+		{
+			name:   "custom CA bundle",
+			config: TransportConfig{RootCAs: rootCAs},
+		},
+		{
+			name:   "insecure skip verify",
+			config: TransportConfig{InsecureSkipVerify: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient("test-issuer", "test-key", testPrivateKey,
+				WithBaseURL(server.URL+"/v1"),
+				WithTransport(newHTTPTransport(tt.config)),
+				WithMaxRetries(0),
+			)
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			_, err = client.Do(context.Background(), Request{Method: http.MethodGet, Endpoint: "/passTypeIds/P1"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseHTTPURL(t *testing.T) {
+	tests := []struct {
+		rawURL  string
+		wantErr bool
+	}{
+		{rawURL: "https://api.appstoreconnect.apple.com/v1"},
+		{rawURL: "http://localhost:8080/v1"},
+		{rawURL: "proxy.example.com:3128", wantErr: true},
+		{rawURL: "ftp://proxy.example.com", wantErr: true},
+		{rawURL: "https://", wantErr: true},
+		{rawURL: "://missing-scheme", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rawURL, func(t *testing.T) {
+			if _, err := parseHTTPURL(tt.rawURL); (err != nil) != tt.wantErr {
+				t.Errorf("parseHTTPURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewCertPool_Invalid(t *testing.T) {
+	if _, err := newCertPool([]byte("not a certificate")); err == nil {
+		t.Error("Expected error for CA bundle without certificates, got nil")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// csrKeyAlgorithms lists the CSR key algorithms Apple accepts for each certificate type.
+// Apple requires 2048-bit RSA keys for every certificate type, including Pass Type ID
+// certificates, so generate_key only offers RSA_2048. Certificate types not listed
+// here are not checked.
+var csrKeyAlgorithms = map[string][]string{
+	CertificateTypeIOSDevelopment:           {KeyAlgorithmRSA2048},
+	CertificateTypeIOSDistribution:          {KeyAlgorithmRSA2048},
+	CertificateTypeMacAppDevelopment:        {KeyAlgorithmRSA2048},
+	CertificateTypeMacAppDistribution:       {KeyAlgorithmRSA2048},
+	CertificateTypeMacInstallerDistribution: {KeyAlgorithmRSA2048},
+	CertificateTypePassTypeID:               {KeyAlgorithmRSA2048},
+	CertificateTypePassTypeIDWithNFC:        {KeyAlgorithmRSA2048},
+	CertificateTypeDeveloperIDKext:          {KeyAlgorithmRSA2048},
+	CertificateTypeDeveloperIDApplication:   {KeyAlgorithmRSA2048},
+	CertificateTypeDevelopmentPushSSL:       {KeyAlgorithmRSA2048},
+	CertificateTypeProductionPushSSL:        {KeyAlgorithmRSA2048},
+	CertificateTypePushSSL:                  {KeyAlgorithmRSA2048},
+}
+
+// CSRContentValidator validates a PEM encoded certificate signing request before it is
+// sent to Apple: the CSR must parse, carry a valid signature, and use a key accepted for
+// the certificate_type attribute of the same resource.
+type CSRContentValidator struct{}
+
+// NewCSRContentValidator creates a new instance of the validator.
+func NewCSRContentValidator() validator.String {
+	return CSRContentValidator{}
+}
+
+// Description returns a human-readable description of the validator.
+func (v CSRContentValidator) Description(ctx context.Context) string {
+	return "value must be a PEM encoded CSR with a valid signature and a key accepted for the certificate type"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v CSRContentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v CSRContentValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	csr, err := parseCSRContent(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CSR",
+			fmt.Sprintf("Unable to parse csr_content as a PEM encoded certificate signing request: %s", err),
+		)
+		return
+	}
+
+	if err := csr.CheckSignature(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CSR Signature",
+			fmt.Sprintf("The signature of the certificate signing request is invalid: %s. Regenerate the CSR with the private key it contains.", err),
+		)
+		return
+	}
+
+	var certType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("certificate_type"), &certType)...)
+	if resp.Diagnostics.HasError() || certType.IsNull() || certType.IsUnknown() {
+		return
+	}
+
+	accepted, ok := csrKeyAlgorithms[certType.ValueString()]
+	if !ok {
+		return
+	}
+
+	if algorithm, ok := csrKeyAlgorithm(csr); !ok || !slices.Contains(accepted, algorithm) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unsupported CSR Key",
+			fmt.Sprintf("The certificate signing request uses %s key, which Apple does not accept for %s certificates. Supported keys: %s.",
+				describePublicKey(csr.PublicKey), certType.ValueString(), strings.Join(accepted, ", ")),
+		)
+	}
+}
+
+// csrKeyAlgorithm returns the key algorithm constant matching the public key of csr.
+func csrKeyAlgorithm(csr *x509.CertificateRequest) (string, bool) {
+	switch pub := csr.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() == 2048 {
+			return KeyAlgorithmRSA2048, true
+		}
+	}
+	return "", false
+}
+
+// describePublicKey returns a short description of a public key for diagnostics.
+func describePublicKey(publicKey any) string {
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("a %d-bit RSA", pub.N.BitLen())
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("an ECDSA %s", pub.Curve.Params().Name)
+	case ed25519.PublicKey:
+		return "an Ed25519"
+	default:
+		return fmt.Sprintf("an unsupported %T", publicKey)
+	}
+}
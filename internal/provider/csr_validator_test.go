@@ -0,0 +1,165 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// createTestCSR creates a PEM encoded CSR signed by key.
+func createTestCSR(t *testing.T, key crypto.Signer) string {
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "True Tickets Signing"},
+	}, key)
+	if err != nil {
+		t.Fatalf("Failed to create CSR: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+// certificateConfig returns a certificate resource configuration with certificate_type
+// set to certType.
+func certificateConfig(t *testing.T, certType types.String) tfsdk.Config {
+	data := testCertificateResourceModel("")
+	data.CertificateType = certType
+	state := testCertificateResourceState(t, data)
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestCSRContentValidator(t *testing.T) {
+	rsa2048, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	rsa1024, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate ECDSA key: %v", err)
+	}
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate ECDSA key: %v", err)
+	}
+
+	// Flip a byte of the signature, which is at the end of the DER encoding
+	rsaCSR := createTestCSR(t, rsa2048)
+	block, _ := pem.Decode([]byte(rsaCSR))
+	block.Bytes[len(block.Bytes)-1] ^= 0xff
+	tamperedCSR := string(pem.EncodeToMemory(block))
+
+	tests := []struct {
+		name        string
+		certType    types.String
+		value       types.String
+		wantSummary string
+	}{
+		{
+			name:     "rsa 2048 for ios distribution",
+			certType: types.StringValue(CertificateTypeIOSDistribution),
+			value:    types.StringValue(rsaCSR),
+		},
+		{
+			name:        "ecdsa p256 for pass type id",
+			certType:    types.StringValue(CertificateTypePassTypeID),
+			value:       types.StringValue(createTestCSR(t, p256)),
+			wantSummary: "Unsupported CSR Key",
+		},
+		{
+			name:        "ecdsa p256 for pass type id with nfc",
+			certType:    types.StringValue(CertificateTypePassTypeIDWithNFC),
+			value:       types.StringValue(createTestCSR(t, p256)),
+			wantSummary: "Unsupported CSR Key",
+		},
+		{
+			name:        "ecdsa p256 for ios distribution",
+			certType:    types.StringValue(CertificateTypeIOSDistribution),
+			value:       types.StringValue(createTestCSR(t, p256)),
+			wantSummary: "Unsupported CSR Key",
+		},
+		{
+			name:        "ecdsa p384 for pass type id",
+			certType:    types.StringValue(CertificateTypePassTypeID),
+			value:       types.StringValue(createTestCSR(t, p384)),
+			wantSummary: "Unsupported CSR Key",
+		},
+		{
+			name:        "rsa 1024 for pass type id",
+			certType:    types.StringValue(CertificateTypePassTypeID),
+			value:       types.StringValue(createTestCSR(t, rsa1024)),
+			wantSummary: "Unsupported CSR Key",
+		},
+		{
+			name:     "unknown certificate type",
+			certType: types.StringUnknown(),
+			value:    types.StringValue(createTestCSR(t, p384)),
+		},
+		{
+			name:        "tampered signature",
+			certType:    types.StringValue(CertificateTypePassTypeID),
+			value:       types.StringValue(tamperedCSR),
+			wantSummary: "Invalid CSR Signature",
+		},
+		{
+			name:        "not pem",
+			certType:    types.StringValue(CertificateTypePassTypeID),
+			value:       types.StringValue("csr"),
+			wantSummary: "Invalid CSR",
+		},
+		{
+			name:     "null",
+			certType: types.StringValue(CertificateTypePassTypeID),
+			value:    types.StringNull(),
+		},
+		{
+			name:     "unknown",
+			certType: types.StringValue(CertificateTypePassTypeID),
+			value:    types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("csr_content"),
+				ConfigValue: tt.value,
+				Config:      certificateConfig(t, tt.certType),
+			}
+			resp := &validator.StringResponse{}
+
+			NewCSRContentValidator().ValidateString(context.Background(), req, resp)
+
+			if tt.wantSummary == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("Expected 1 error, got: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.Errors()[0].Summary(); got != tt.wantSummary {
+				t.Errorf("Summary = %q, want %q", got, tt.wantSummary)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DERToPEMFunction{}
+
+func NewDERToPEMFunction() function.Function {
+	return &DERToPEMFunction{}
+}
+
+// DERToPEMFunction defines the function implementation.
+type DERToPEMFunction struct{}
+
+func (f *DERToPEMFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "der_to_pem"
+}
+
+func (f *DERToPEMFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert a base64 encoded DER certificate to PEM",
+		MarkdownDescription: "Converts a base64 encoded DER certificate, such as the `certificate_content` attribute of a certificate, to base64 encoded PEM format. The result matches the `certificate_content_pem` attribute.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "certificate_content",
+				MarkdownDescription: "The base64 encoded DER certificate.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DERToPEMFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certificateContent string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &certificateContent))
+	if resp.Error != nil {
+		return
+	}
+
+	certificatePEM, err := convertDERToPEM(certificateContent)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to convert certificate to PEM: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, certificatePEM))
+}
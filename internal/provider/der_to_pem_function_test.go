@@ -0,0 +1,97 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDERToPEMFunction_Known(t *testing.T) {
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+	certificateContent := base64.StdEncoding.EncodeToString(certBlock.Bytes)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+output "test" {
+  value = provider::appleappstoreconnect::der_to_pem(%q)
+}
+`, certificateContent),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(base64.StdEncoding.EncodeToString(certPEM))),
+				},
+			},
+		},
+	})
+}
+
+func TestDERToPEMFunctionRun(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, _, _ := createTestCertificateAndKey(t)
+	certBlock, _ := pem.Decode(certPEM)
+
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantError bool
+	}{
+		{
+			name:  "valid certificate",
+			input: base64.StdEncoding.EncodeToString(certBlock.Bytes),
+			want:  base64.StdEncoding.EncodeToString(certPEM),
+		},
+		{
+			name:      "invalid base64",
+			input:     "not-valid-base64!",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.input)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&DERToPEMFunction{}).Run(ctx, req, resp)
+
+			if tt.wantError {
+				if resp.Error == nil {
+					t.Fatal("Expected error, got nil")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Expected no error, got: %s", resp.Error)
+			}
+
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Result = %s, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,452 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeviceResource{}
+var _ resource.ResourceWithImportState = &DeviceResource{}
+
+// deviceErrorPointers maps JSON:API error source pointers to resource attributes.
+var deviceErrorPointers = map[string]path.Path{
+	"/data/attributes/name":     path.Root("name"),
+	"/data/attributes/platform": path.Root("platform"),
+	"/data/attributes/udid":     path.Root("udid"),
+	"/data/attributes/status":   path.Root("status"),
+}
+
+// NewDeviceResource creates a new Device resource.
+func NewDeviceResource() resource.Resource {
+	return &DeviceResource{}
+}
+
+// DeviceResource defines the resource implementation.
+type DeviceResource struct {
+	client *Client
+}
+
+// DeviceResourceModel describes the resource data model.
+type DeviceResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	UDID        types.String `tfsdk:"udid"`
+	Platform    types.String `tfsdk:"platform"`
+	Status      types.String `tfsdk:"status"`
+	DeviceClass types.String `tfsdk:"device_class"`
+	Model       types.String `tfsdk:"model"`
+	AddedDate   types.String `tfsdk:"added_date"`
+}
+
+func (r *DeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (r *DeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers a device in App Store Connect. Apple does not allow devices to be deleted, so destroying this resource disables the device instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the device.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the device. Can be updated in place.",
+				Required:            true,
+			},
+			"udid": schema.StringAttribute{
+				MarkdownDescription: "The UDID of the device.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "The platform of the device. Valid values are: `IOS`, `MAC_OS`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						BundleIDPlatformIOS,
+						BundleIDPlatformMacOS,
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the device. Valid values are: `ENABLED`, `DISABLED`. Default is `ENABLED`. Can be updated in place.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(DeviceStatusEnabled),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						DeviceStatusEnabled,
+						DeviceStatusDisabled,
+					),
+				},
+			},
+			"device_class": schema.StringAttribute{
+				MarkdownDescription: "The class of the device (e.g., `IPHONE`, `IPAD`, `MAC`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model of the device.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"added_date": schema.StringAttribute{
+				MarkdownDescription: "The date the device was registered.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the request
+	createReq := DeviceCreateRequest{
+		Data: DeviceCreateRequestData{
+			Type: "devices",
+			Attributes: DeviceCreateRequestAttributes{
+				Name:     data.Name.ValueString(),
+				Platform: data.Platform.ValueString(),
+				UDID:     data.UDID.ValueString(),
+			},
+		},
+	}
+
+	tflog.Debug(ctx, "Registering device", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"udid":     data.UDID.ValueString(),
+		"platform": data.Platform.ValueString(),
+	})
+
+	// Make the API request
+	var device *Device
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodPost,
+		Endpoint: "/devices",
+		Body:     createReq,
+	})
+	switch {
+	case err == nil:
+		device = &Device{}
+		if err := json.Unmarshal(apiResp.Data, device); err != nil {
+			resp.Diagnostics.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to parse device response, got error: %s", err),
+			)
+			return
+		}
+	case IsConflict(err):
+		// Devices cannot be deleted, so a device destroyed earlier is still
+		// registered (disabled). Adopt it instead of failing.
+		existing, lookupErr := r.findDeviceByUDID(ctx, data.UDID.ValueString(), data.Platform.ValueString())
+		if lookupErr != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to look up existing device, got error: %s", lookupErr),
+			)
+			return
+		}
+		if existing == nil {
+			addClientErrorDiagnostic(&resp.Diagnostics, "Unable to register device", err, deviceErrorPointers)
+			return
+		}
+		device = existing
+
+		tflog.Info(ctx, "Device is already registered, adopting it", map[string]interface{}{
+			"id":     device.ID,
+			"udid":   device.Attributes.UDID,
+			"status": device.Attributes.Status,
+		})
+	default:
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to register device", err, deviceErrorPointers)
+		return
+	}
+
+	// Validate that we got an ID from the API
+	if device.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid API Response",
+			"The API response did not contain a valid ID for the registered device",
+		)
+		return
+	}
+
+	// Newly registered devices are enabled; apply the planned name and status if they differ
+	if device.Attributes.Name != data.Name.ValueString() || device.Attributes.Status != data.Status.ValueString() {
+		device, err = r.updateDevice(ctx, device.ID, data.Name.ValueString(), data.Status.ValueString())
+		if err != nil {
+			addClientErrorDiagnostic(&resp.Diagnostics, "Unable to update device", err, deviceErrorPointers)
+			return
+		}
+	}
+
+	updateDeviceModel(&data, device)
+
+	tflog.Trace(ctx, "Registered device", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading device", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/devices/%s", data.ID.ValueString()),
+	})
+	if err != nil {
+		if removeResourceIfNotFound(ctx, resp, err, "Device", data.ID.ValueString()) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read device, got error: %s", err),
+		)
+		return
+	}
+
+	// Parse the response
+	var device Device
+	if err := json.Unmarshal(apiResp.Data, &device); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse device response, got error: %s", err),
+		)
+		return
+	}
+
+	updateDeviceModel(&data, &device)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DeviceResourceModel
+	var state DeviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating device", map[string]interface{}{
+		"id":     state.ID.ValueString(),
+		"name":   plan.Name.ValueString(),
+		"status": plan.Status.ValueString(),
+	})
+
+	// Only the name and status can be changed in place
+	device, err := r.updateDevice(ctx, state.ID.ValueString(), plan.Name.ValueString(), plan.Status.ValueString())
+	if err != nil {
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to update device", err, deviceErrorPointers)
+		return
+	}
+
+	updateDeviceModel(&plan, device)
+
+	tflog.Trace(ctx, "Updated device", map[string]interface{}{
+		"id": plan.ID.ValueString(),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apple does not allow devices to be deleted, so disable the device instead
+	tflog.Debug(ctx, "Disabling device", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err := r.updateDevice(ctx, data.ID.ValueString(), "", DeviceStatusDisabled)
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to disable device, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Disabled device", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *DeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateDevice changes the name and status of a device. Empty values are left unchanged.
+func (r *DeviceResource) updateDevice(ctx context.Context, id, name, status string) (*Device, error) {
+	updateReq := DeviceUpdateRequest{
+		Data: DeviceUpdateRequestData{
+			Type: "devices",
+			ID:   id,
+			Attributes: DeviceUpdateRequestAttributes{
+				Name:   name,
+				Status: status,
+			},
+		},
+	}
+
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodPatch,
+		Endpoint: fmt.Sprintf("/devices/%s", id),
+		Body:     updateReq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var device Device
+	if err := json.Unmarshal(apiResp.Data, &device); err != nil {
+		return nil, fmt.Errorf("unable to parse device response: %w", err)
+	}
+
+	return &device, nil
+}
+
+// findDeviceByUDID returns the registered device with the given UDID and platform, or
+// nil if there is none. UDIDs are compared case-insensitively.
+func (r *DeviceResource) findDeviceByUDID(ctx context.Context, udid, platform string) (*Device, error) {
+	apiResp, err := r.client.DoAll(ctx, Request{
+		Endpoint: "/devices",
+		Query: map[string]string{
+			"filter[udid]":     udid,
+			"filter[platform]": platform,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []Device
+	if err := json.Unmarshal(apiResp.Data, &devices); err != nil {
+		return nil, fmt.Errorf("unable to parse devices response: %w", err)
+	}
+
+	for i := range devices {
+		if strings.EqualFold(devices[i].Attributes.UDID, udid) {
+			return &devices[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// updateDeviceModel updates the resource model with the device data.
+func updateDeviceModel(model *DeviceResourceModel, device *Device) {
+	model.ID = types.StringValue(device.ID)
+	model.Name = types.StringValue(device.Attributes.Name)
+	model.Platform = types.StringValue(device.Attributes.Platform)
+	model.Status = types.StringValue(device.Attributes.Status)
+	model.DeviceClass = types.StringValue(device.Attributes.DeviceClass)
+	model.Model = types.StringValue(device.Attributes.Model)
+
+	// Keep the configured UDID when it only differs in case
+	if !strings.EqualFold(model.UDID.ValueString(), device.Attributes.UDID) {
+		model.UDID = types.StringValue(device.Attributes.UDID)
+	}
+
+	if device.Attributes.AddedDate != nil {
+		model.AddedDate = types.StringValue(device.Attributes.AddedDate.Format("2006-01-02T15:04:05Z"))
+	} else {
+		model.AddedDate = types.StringNull()
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceResource(t *testing.T) {
+	// Apple limits how many devices can be registered per membership year,
+	// so only run against an explicitly provided test device.
+	udid := os.Getenv("APP_STORE_CONNECT_TEST_DEVICE_UDID")
+	if udid == "" {
+		t.Skip("APP_STORE_CONNECT_TEST_DEVICE_UDID must be set to run device acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeviceResourceConfig(udid, "Test Device", DeviceStatusEnabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appleappstoreconnect_device.test", "name", "Test Device"),
+					resource.TestCheckResourceAttr("appleappstoreconnect_device.test", "status", DeviceStatusEnabled),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_device.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "appleappstoreconnect_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update name and status in place
+			{
+				Config: testAccDeviceResourceConfig(udid, "Renamed Test Device", DeviceStatusDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appleappstoreconnect_device.test", "name", "Renamed Test Device"),
+					resource.TestCheckResourceAttr("appleappstoreconnect_device.test", "status", DeviceStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeviceResourceConfig(udid, name, status string) string {
+	return fmt.Sprintf(`
+resource "appleappstoreconnect_device" "test" {
+  name     = %[2]q
+  udid     = %[1]q
+  platform = "IOS"
+  status   = %[3]q
+}
+`, udid, name, status)
+}
+
+func TestDeviceResourceCreate_AdoptsExistingDevice(t *testing.T) {
+	ctx := context.Background()
+
+	var patched DeviceUpdateRequest
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/devices":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"errors":[{"status":"409","code":"ENTITY_ERROR.ATTRIBUTE.INVALID","title":"An attribute value is invalid.","detail":"A device with number '00008030-001A2B3C4D5E6F70' already exists on this team."}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/devices":
+			if got := r.URL.Query().Get("filter[udid]"); got != "00008030-001A2B3C4D5E6F70" {
+				t.Errorf("filter[udid] = %q, want the configured UDID", got)
+			}
+			_, _ = w.Write([]byte(`{"data":[{"type":"devices","id":"DEVICE1","attributes":{"name":"Old Name","platform":"IOS","udid":"00008030-001a2b3c4d5e6f70","deviceClass":"IPHONE","status":"DISABLED","model":"iPhone 11"}}]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/devices/DEVICE1":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &patched); err != nil {
+				t.Errorf("Failed to parse PATCH body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"data":{"type":"devices","id":"DEVICE1","attributes":{"name":"QA iPhone","platform":"IOS","udid":"00008030-001a2b3c4d5e6f70","deviceClass":"IPHONE","status":"ENABLED","model":"iPhone 11"}}}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := &DeviceResource{client: client}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, &DeviceResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("QA iPhone"),
+		UDID:        types.StringValue("00008030-001A2B3C4D5E6F70"),
+		Platform:    types.StringValue("IOS"),
+		Status:      types.StringValue(DeviceStatusEnabled),
+		DeviceClass: types.StringUnknown(),
+		Model:       types.StringUnknown(),
+		AddedDate:   types.StringUnknown(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to set plan: %v", diags)
+	}
+
+	resp := &fwresource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	if patched.Data.Attributes.Name != "QA iPhone" || patched.Data.Attributes.Status != DeviceStatusEnabled {
+		t.Errorf("PATCH attributes = %+v, want the planned name and status", patched.Data.Attributes)
+	}
+
+	var got DeviceResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to get state: %v", resp.Diagnostics)
+	}
+
+	if got.ID.ValueString() != "DEVICE1" {
+		t.Errorf("id = %q, want %q", got.ID.ValueString(), "DEVICE1")
+	}
+	if got.UDID.ValueString() != "00008030-001A2B3C4D5E6F70" {
+		t.Errorf("udid = %q, want the configured UDID", got.UDID.ValueString())
+	}
+	if got.Status.ValueString() != DeviceStatusEnabled {
+		t.Errorf("status = %q, want %q", got.Status.ValueString(), DeviceStatusEnabled)
+	}
+}
+
+func TestDeviceResourceDelete_Disables(t *testing.T) {
+	ctx := context.Background()
+
+	var patched DeviceUpdateRequest
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/v1/devices/DEVICE1" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &patched); err != nil {
+			t.Errorf("Failed to parse PATCH body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"data":{"type":"devices","id":"DEVICE1","attributes":{"name":"QA iPhone","platform":"IOS","udid":"00008030-001A2B3C4D5E6F70","status":"DISABLED"}}}`))
+	})
+
+	r := &DeviceResource{client: client}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("id"), "DEVICE1")
+	if diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	if patched.Data.Attributes.Status != DeviceStatusDisabled {
+		t.Errorf("status = %q, want %q", patched.Data.Attributes.Status, DeviceStatusDisabled)
+	}
+	if patched.Data.Attributes.Name != "" {
+		t.Errorf("name = %q, want the name to be left unchanged", patched.Data.Attributes.Name)
+	}
+}
+
+func TestDeviceResourceRead_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'devices' with id 'DEVICE1'"}]}`))
+	})
+
+	r := &DeviceResource{client: client}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("id"), "DEVICE1")
+	if diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+)
+
+// Device represents a registered device in the App Store Connect API.
+type Device struct {
+	Type       string           `json:"type"`
+	ID         string           `json:"id"`
+	Attributes DeviceAttributes `json:"attributes"`
+	Links      ResourceLinks    `json:"links,omitempty"`
+}
+
+// DeviceAttributes represents the attributes of a device.
+type DeviceAttributes struct {
+	Name        string     `json:"name"`
+	Platform    string     `json:"platform"`
+	UDID        string     `json:"udid"`
+	DeviceClass string     `json:"deviceClass,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	Model       string     `json:"model,omitempty"`
+	AddedDate   *time.Time `json:"addedDate,omitempty"`
+}
+
+// DeviceCreateRequest represents the request body for registering a device.
+type DeviceCreateRequest struct {
+	Data DeviceCreateRequestData `json:"data"`
+}
+
+// DeviceCreateRequestData represents the data for registering a device.
+type DeviceCreateRequestData struct {
+	Type       string                        `json:"type"`
+	Attributes DeviceCreateRequestAttributes `json:"attributes"`
+}
+
+// DeviceCreateRequestAttributes represents the attributes for registering a device.
+type DeviceCreateRequestAttributes struct {
+	Name     string `json:"name"`
+	Platform string `json:"platform"`
+	UDID     string `json:"udid"`
+}
+
+// DeviceUpdateRequest represents the request body for updating a device.
+type DeviceUpdateRequest struct {
+	Data DeviceUpdateRequestData `json:"data"`
+}
+
+// DeviceUpdateRequestData represents the data for updating a device.
+type DeviceUpdateRequestData struct {
+	Type       string                        `json:"type"`
+	ID         string                        `json:"id"`
+	Attributes DeviceUpdateRequestAttributes `json:"attributes"`
+}
+
+// DeviceUpdateRequestAttributes represents the attributes for updating a device.
+type DeviceUpdateRequestAttributes struct {
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// Device statuses.
+const (
+	DeviceStatusEnabled  = "ENABLED"
+	DeviceStatusDisabled = "DISABLED"
+)
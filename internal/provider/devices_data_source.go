@@ -0,0 +1,271 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DevicesDataSource{}
+
+// NewDevicesDataSource creates a new Devices data source.
+func NewDevicesDataSource() datasource.DataSource {
+	return &DevicesDataSource{}
+}
+
+// DevicesDataSource defines the data source implementation.
+type DevicesDataSource struct {
+	client *Client
+}
+
+// DevicesDataSourceModel describes the data source data model.
+type DevicesDataSourceModel struct {
+	Devices types.List   `tfsdk:"devices"`
+	Filter  types.Object `tfsdk:"filter"`
+}
+
+// DevicesFilterModel describes the filter criteria.
+type DevicesFilterModel struct {
+	Platform types.String `tfsdk:"platform"`
+	Status   types.String `tfsdk:"status"`
+	Name     types.String `tfsdk:"name"`
+}
+
+// DeviceListItemModel describes a device in the list.
+type DeviceListItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	UDID        types.String `tfsdk:"udid"`
+	Platform    types.String `tfsdk:"platform"`
+	Status      types.String `tfsdk:"status"`
+	DeviceClass types.String `tfsdk:"device_class"`
+	Model       types.String `tfsdk:"model"`
+	AddedDate   types.String `tfsdk:"added_date"`
+}
+
+func (d *DevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *DevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to retrieve a list of registered devices from App Store Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "List of devices matching the filter criteria.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the device.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the device.",
+							Computed:            true,
+						},
+						"udid": schema.StringAttribute{
+							MarkdownDescription: "The UDID of the device.",
+							Computed:            true,
+						},
+						"platform": schema.StringAttribute{
+							MarkdownDescription: "The platform of the device.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the device.",
+							Computed:            true,
+						},
+						"device_class": schema.StringAttribute{
+							MarkdownDescription: "The class of the device.",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "The model of the device.",
+							Computed:            true,
+						},
+						"added_date": schema.StringAttribute{
+							MarkdownDescription: "The date the device was registered.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Filter criteria for listing devices.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"platform": schema.StringAttribute{
+						MarkdownDescription: "Filter by platform.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								BundleIDPlatformIOS,
+								BundleIDPlatformMacOS,
+							),
+						},
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Filter by status.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								DeviceStatusEnabled,
+								DeviceStatusDisabled,
+							),
+						},
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Filter by name. Matches every device whose name contains this value (a case-sensitive substring match), not only an exact name. The match is applied client-side, after fetching the devices that match the other filters.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DevicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build query parameters
+	query := make(map[string]string)
+
+	// Extract filter criteria if present
+	var filter DevicesFilterModel
+	if !data.Filter.IsNull() {
+		resp.Diagnostics.Append(data.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !filter.Platform.IsNull() {
+			query["filter[platform]"] = filter.Platform.ValueString()
+		}
+		if !filter.Status.IsNull() {
+			query["filter[status]"] = filter.Status.ValueString()
+		}
+
+		tflog.Debug(ctx, "Fetching Devices with filter", map[string]interface{}{
+			"platform": filter.Platform.ValueString(),
+			"status":   filter.Status.ValueString(),
+			"name":     filter.Name.ValueString(),
+		})
+	} else {
+		tflog.Debug(ctx, "Fetching all Devices")
+	}
+
+	// Make the API request, following every page of results
+	apiResp, err := d.client.DoAll(ctx, Request{
+		Endpoint: "/devices",
+		Query:    query,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list Devices, got error: %s", err),
+		)
+		return
+	}
+
+	// Parse the response - apiResp.Data contains the merged array from every page
+	var devices []Device
+	if err := json.Unmarshal(apiResp.Data, &devices); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse Devices response, got error: %s", err),
+		)
+		return
+	}
+
+	// Convert devices to list items, applying the name filter client-side
+	deviceItems := make([]DeviceListItemModel, 0, len(devices))
+	for _, device := range devices {
+		if name := filter.Name.ValueString(); name != "" && !strings.Contains(device.Attributes.Name, name) {
+			continue
+		}
+
+		item := DeviceListItemModel{
+			ID:          types.StringValue(device.ID),
+			Name:        types.StringValue(device.Attributes.Name),
+			UDID:        types.StringValue(device.Attributes.UDID),
+			Platform:    types.StringValue(device.Attributes.Platform),
+			Status:      types.StringValue(device.Attributes.Status),
+			DeviceClass: types.StringValue(device.Attributes.DeviceClass),
+			Model:       types.StringValue(device.Attributes.Model),
+		}
+
+		if device.Attributes.AddedDate != nil {
+			item.AddedDate = types.StringValue(device.Attributes.AddedDate.Format("2006-01-02T15:04:05Z"))
+		} else {
+			item.AddedDate = types.StringNull()
+		}
+
+		deviceItems = append(deviceItems, item)
+	}
+
+	// Create the list value
+	deviceList, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":           types.StringType,
+			"name":         types.StringType,
+			"udid":         types.StringType,
+			"platform":     types.StringType,
+			"status":       types.StringType,
+			"device_class": types.StringType,
+			"model":        types.StringType,
+			"added_date":   types.StringType,
+		},
+	}, deviceItems)
+	resp.Diagnostics.Append(diags...)
+	data.Devices = deviceList
+
+	tflog.Debug(ctx, "Found devices", map[string]interface{}{
+		"count": len(deviceItems),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing without filter
+			{
+				Config: testAccDevicesDataSourceConfigNoFilter(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.appleappstoreconnect_devices.test", "devices.#"),
+				),
+			},
+			// Read testing with platform and status filters
+			{
+				Config: testAccDevicesDataSourceConfigWithFilters(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.appleappstoreconnect_devices.test", "devices.#"),
+					resource.TestCheckResourceAttr("data.appleappstoreconnect_devices.test", "filter.platform", "IOS"),
+					resource.TestCheckResourceAttr("data.appleappstoreconnect_devices.test", "filter.status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDevicesDataSourceConfigNoFilter() string {
+	return `
+data "appleappstoreconnect_devices" "test" {
+}
+`
+}
+
+func testAccDevicesDataSourceConfigWithFilters() string {
+	return `
+data "appleappstoreconnect_devices" "test" {
+  filter = {
+    platform = "IOS"
+    status   = "ENABLED"
+  }
+}
+`
+}
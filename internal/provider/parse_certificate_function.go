@@ -0,0 +1,102 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseCertificateFunction{}
+
+// parsedCertificateAttrTypes are the attribute types of the object returned by parse_certificate.
+var parsedCertificateAttrTypes = map[string]attr.Type{
+	"subject_dn":         types.StringType,
+	"serial_number":      types.StringType,
+	"expiration_date":    types.StringType,
+	"sha1_fingerprint":   types.StringType,
+	"sha256_fingerprint": types.StringType,
+	"ca_issuers":         types.ListType{ElemType: types.StringType},
+}
+
+func NewParseCertificateFunction() function.Function {
+	return &ParseCertificateFunction{}
+}
+
+// ParseCertificateFunction defines the function implementation.
+type ParseCertificateFunction struct{}
+
+// ParsedCertificateModel describes the object returned by parse_certificate.
+type ParsedCertificateModel struct {
+	SubjectDN         types.String `tfsdk:"subject_dn"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	ExpirationDate    types.String `tfsdk:"expiration_date"`
+	SHA1Fingerprint   types.String `tfsdk:"sha1_fingerprint"`
+	SHA256Fingerprint types.String `tfsdk:"sha256_fingerprint"`
+	CAIssuers         types.List   `tfsdk:"ca_issuers"`
+}
+
+func (f *ParseCertificateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_certificate"
+}
+
+func (f *ParseCertificateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a base64 encoded DER certificate",
+		MarkdownDescription: "Parses a base64 encoded DER certificate, such as the `certificate_content` attribute of a certificate, and returns an object with its `subject_dn`, `serial_number` (uppercase hex, as reported by App Store Connect), `expiration_date`, hex encoded `sha1_fingerprint` and `sha256_fingerprint`, and the `ca_issuers` URIs from its Authority Information Access extension.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "certificate_content",
+				MarkdownDescription: "The base64 encoded DER certificate.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedCertificateAttrTypes,
+		},
+	}
+}
+
+func (f *ParseCertificateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certificateContent string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &certificateContent))
+	if resp.Error != nil {
+		return
+	}
+
+	cert, err := decodeCertificate(certificateContent)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse certificate: %s", err))
+		return
+	}
+
+	caIssuers, diags := types.ListValueFrom(ctx, types.StringType, cert.IssuingCertificateURL)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	sha1Sum := sha1.Sum(cert.Raw)
+	sha256Sum := sha256.Sum256(cert.Raw)
+
+	result := ParsedCertificateModel{
+		SubjectDN:         types.StringValue(cert.Subject.String()),
+		SerialNumber:      types.StringValue(strings.ToUpper(cert.SerialNumber.Text(16))),
+		ExpirationDate:    types.StringValue(cert.NotAfter.UTC().Format("2006-01-02T15:04:05Z")),
+		SHA1Fingerprint:   types.StringValue(hex.EncodeToString(sha1Sum[:])),
+		SHA256Fingerprint: types.StringValue(hex.EncodeToString(sha256Sum[:])),
+		CAIssuers:         caIssuers,
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,151 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// createTestCertificateWithIssuers creates a self-signed certificate with a CA Issuers URI
+// and returns it base64 encoded DER, as App Store Connect returns certificate content.
+func createTestCertificateWithIssuers(t *testing.T) (string, []byte) {
+	issuer := issueTestCertificate(t, "Test WWDR G4", true, nil)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(0x7A3F),
+		Subject:               pkix.Name{CommonName: "Pass Type ID: pass.io.truetickets.test"},
+		NotBefore:             time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		NotAfter:              time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		IssuingCertificateURL: []string{"https://www.apple.com/certificateauthority/AppleWWDRCAG4.cer"},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer.cert, &issuer.key.PublicKey, issuer.key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(der), der
+}
+
+func TestParseCertificateFunction_Known(t *testing.T) {
+	certificateContent, der := createTestCertificateWithIssuers(t)
+	sum := sha256.Sum256(der)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+output "test" {
+  value = provider::appleappstoreconnect::parse_certificate(%q)
+}
+`, certificateContent),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"subject_dn":         knownvalue.StringExact("CN=Pass Type ID: pass.io.truetickets.test"),
+						"serial_number":      knownvalue.StringExact("7A3F"),
+						"expiration_date":    knownvalue.StringExact("2026-01-02T03:04:05Z"),
+						"sha256_fingerprint": knownvalue.StringExact(hex.EncodeToString(sum[:])),
+						"ca_issuers": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("https://www.apple.com/certificateauthority/AppleWWDRCAG4.cer"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestParseCertificateFunctionRun(t *testing.T) {
+	ctx := context.Background()
+
+	certificateContent, der := createTestCertificateWithIssuers(t)
+	sum := sha256.Sum256(der)
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(certificateContent)}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(parsedCertificateAttrTypes)),
+	}
+
+	(&ParseCertificateFunction{}).Run(ctx, req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("Expected no error, got: %s", resp.Error)
+	}
+
+	result, ok := resp.Result.Value().(types.Object)
+	if !ok {
+		t.Fatalf("Result is %T, want types.Object", resp.Result.Value())
+	}
+
+	var got ParsedCertificateModel
+	if diags := result.As(ctx, &got, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("Failed to read result: %v", diags)
+	}
+
+	if got.SubjectDN.ValueString() != "CN=Pass Type ID: pass.io.truetickets.test" {
+		t.Errorf("subject_dn = %q", got.SubjectDN.ValueString())
+	}
+	if got.SerialNumber.ValueString() != "7A3F" {
+		t.Errorf("serial_number = %q, want %q", got.SerialNumber.ValueString(), "7A3F")
+	}
+	if got.ExpirationDate.ValueString() != "2026-01-02T03:04:05Z" {
+		t.Errorf("expiration_date = %q, want %q", got.ExpirationDate.ValueString(), "2026-01-02T03:04:05Z")
+	}
+	if got.SHA256Fingerprint.ValueString() != hex.EncodeToString(sum[:]) {
+		t.Errorf("sha256_fingerprint = %q, want %q", got.SHA256Fingerprint.ValueString(), hex.EncodeToString(sum[:]))
+	}
+	if len(got.SHA1Fingerprint.ValueString()) != 40 {
+		t.Errorf("len(sha1_fingerprint) = %d, want 40", len(got.SHA1Fingerprint.ValueString()))
+	}
+
+	var issuers []string
+	if diags := got.CAIssuers.ElementsAs(ctx, &issuers, false); diags.HasError() {
+		t.Fatalf("Failed to read ca_issuers: %v", diags)
+	}
+	if len(issuers) != 1 || issuers[0] != "https://www.apple.com/certificateauthority/AppleWWDRCAG4.cer" {
+		t.Errorf("ca_issuers = %v", issuers)
+	}
+}
+
+func TestParseCertificateFunctionRun_Invalid(t *testing.T) {
+	ctx := context.Background()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(base64.StdEncoding.EncodeToString([]byte("not a certificate")))}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(parsedCertificateAttrTypes)),
+	}
+
+	(&ParseCertificateFunction{}).Run(ctx, req, resp)
+
+	if resp.Error == nil {
+		t.Fatal("Expected error for invalid certificate, got nil")
+	}
+}
@@ -167,9 +167,8 @@ func (d *PassTypeIDDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			"identifier": filter.Identifier.ValueString(),
 		})
 
-		// Make the API request to list all Pass Type IDs
-		apiResp, err := d.client.Do(ctx, Request{
-			Method:   http.MethodGet,
+		// Make the API request to list all Pass Type IDs, following every page of results
+		apiResp, err := d.client.DoAll(ctx, Request{
 			Endpoint: "/passTypeIds",
 			Query: map[string]string{
 				"filter[identifier]": filter.Identifier.ValueString(),
@@ -183,7 +182,7 @@ func (d *PassTypeIDDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			return
 		}
 
-		// Parse the response - the API returns an array directly in the data field
+		// Parse the response - apiResp.Data contains the merged array from every page
 		var passTypeIDs []PassTypeID
 		if err := json.Unmarshal(apiResp.Data, &passTypeIDs); err != nil {
 			resp.Diagnostics.AddError(
@@ -33,6 +33,12 @@ type PassTypeIDResource struct {
 	client *Client
 }
 
+// passTypeIDErrorPointers maps JSON:API error source pointers to resource attributes.
+var passTypeIDErrorPointers = map[string]path.Path{
+	"/data/attributes/identifier": path.Root("identifier"),
+	"/data/attributes/name":       path.Root("description"),
+}
+
 // PassTypeIDResourceModel describes the resource data model.
 type PassTypeIDResourceModel struct {
 	ID          types.String `tfsdk:"id"`
@@ -148,10 +154,7 @@ func (r *PassTypeIDResource) Create(ctx context.Context, req resource.CreateRequ
 		Body:     createReq,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Client Error",
-			fmt.Sprintf("Unable to create Pass Type ID, got error: %s", err),
-		)
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to create Pass Type ID", err, passTypeIDErrorPointers)
 		return
 	}
 
@@ -231,6 +234,10 @@ func (r *PassTypeIDResource) Read(ctx context.Context, req resource.ReadRequest,
 		Endpoint: fmt.Sprintf("/passTypeIds/%s", data.ID.ValueString()),
 	})
 	if err != nil {
+		if removeResourceIfNotFound(ctx, resp, err, "Pass Type ID", data.ID.ValueString()) {
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Client Error",
 			fmt.Sprintf("Unable to read Pass Type ID, got error: %s", err),
@@ -4,9 +4,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -124,3 +130,46 @@ func TestIsValidPassTypeIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestPassTypeIDResourceRead_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'passTypeIds' with id 'ABC123'"}]}`))
+	})
+
+	r := &PassTypeIDResource{client: client}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &PassTypeIDResourceModel{
+		ID:          types.StringValue("ABC123"),
+		Identifier:  types.StringValue("pass.io.truetickets.test.deleted"),
+		Description: types.StringValue("Deleted Pass Type"),
+		CreatedDate: types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
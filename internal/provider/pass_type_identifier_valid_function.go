@@ -0,0 +1,49 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PassTypeIdentifierValidFunction{}
+
+func NewPassTypeIdentifierValidFunction() function.Function {
+	return &PassTypeIdentifierValidFunction{}
+}
+
+// PassTypeIdentifierValidFunction defines the function implementation.
+type PassTypeIdentifierValidFunction struct{}
+
+func (f *PassTypeIdentifierValidFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pass_type_identifier_valid"
+}
+
+func (f *PassTypeIdentifierValidFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Check whether a Pass Type ID identifier is valid",
+		MarkdownDescription: "Returns `true` when the identifier uses the reverse-DNS format required by the `appleappstoreconnect_pass_type_id` resource, such as `pass.com.example.membership`. Useful in variable validation and preconditions.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "identifier",
+				MarkdownDescription: "The Pass Type ID identifier to validate.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *PassTypeIdentifierValidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var identifier string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &identifier))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, isValidPassTypeIdentifier(identifier)))
+}
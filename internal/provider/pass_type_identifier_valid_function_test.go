@@ -0,0 +1,78 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPassTypeIdentifierValidFunction_Known(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "valid" {
+  value = provider::appleappstoreconnect::pass_type_identifier_valid("pass.io.truetickets.test")
+}
+
+output "invalid" {
+  value = provider::appleappstoreconnect::pass_type_identifier_valid("io.truetickets.test")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("valid", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("invalid", knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func TestPassTypeIdentifierValidFunctionRun(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		identifier string
+		want       bool
+	}{
+		{identifier: "pass.io.truetickets.test", want: true},
+		{identifier: "pass.com.example.event-ticket", want: true},
+		{identifier: "io.truetickets.test", want: false},
+		{identifier: "pass.", want: false},
+		{identifier: "pass.com.-example", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.identifier)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+
+			(&PassTypeIdentifierValidFunction{}).Run(ctx, req, resp)
+
+			if resp.Error != nil {
+				t.Fatalf("Expected no error, got: %s", resp.Error)
+			}
+			if got := resp.Result.Value(); !got.Equal(types.BoolValue(tt.want)) {
+				t.Errorf("Result = %s, want %t", got, tt.want)
+			}
+		})
+	}
+}
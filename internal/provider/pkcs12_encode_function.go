@@ -0,0 +1,89 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PKCS12EncodeFunction{}
+
+func NewPKCS12EncodeFunction() function.Function {
+	return &PKCS12EncodeFunction{}
+}
+
+// PKCS12EncodeFunction defines the function implementation.
+type PKCS12EncodeFunction struct{}
+
+func (f *PKCS12EncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pkcs12_encode"
+}
+
+func (f *PKCS12EncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Create a PKCS12 bundle from a certificate and private key",
+		MarkdownDescription: "Creates a password protected PKCS12 (.p12) bundle from a PEM encoded certificate and private key and returns it base64 encoded. The bundle uses the `modern` encoding; use the `pkcs12_encoding` attribute of the certificate resource when a legacy encoding is required. The function is deterministic: the salts and IVs of the bundle are derived from a hash of the arguments, so the same arguments always return the same bundle and do not cause a diff on every plan.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "certificate_pem",
+				MarkdownDescription: "The PEM encoded certificate. Use `base64decode()` on the `certificate_content_pem` attribute of a certificate.",
+			},
+			function.StringParameter{
+				Name:                "private_key_pem",
+				MarkdownDescription: "The PEM encoded private key in PKCS#1, SEC 1 or PKCS#8 format.",
+			},
+			function.StringParameter{
+				Name:                "password",
+				MarkdownDescription: "The password used to protect the bundle.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PKCS12EncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certificatePEM, privateKeyPEM, password string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &certificatePEM, &privateKeyPEM, &password))
+	if resp.Error != nil {
+		return
+	}
+
+	// Provider functions must return the same result for the same arguments
+	encoder := pkcs12.Modern.WithRand(pkcs12EncodeRand(certificatePEM, privateKeyPEM, password))
+
+	bundle, err := encodePKCS12Bundle(encoder, certificatePEM, privateKeyPEM, password, nil)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to create PKCS12 bundle: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, bundle))
+}
+
+// pkcs12EncodeRand returns the random source for the salts and IVs of a bundle,
+// seeded from a SHA-256 hash of the arguments. The salts and IVs need to be unique,
+// not secret, so deriving them from the arguments only reveals whether two bundles
+// were built from the same arguments.
+func pkcs12EncodeRand(certificatePEM, privateKeyPEM, password string) *rand.ChaCha8 {
+	hash := sha256.New()
+	hash.Write([]byte("appleappstoreconnect pkcs12_encode\x00"))
+	for _, argument := range []string{certificatePEM, privateKeyPEM, password} {
+		// Length prefixes keep the boundaries between the arguments unambiguous
+		hash.Write(binary.BigEndian.AppendUint64(nil, uint64(len(argument))))
+		hash.Write([]byte(argument))
+	}
+
+	var seed [32]byte
+	copy(seed[:], hash.Sum(nil))
+	return rand.NewChaCha8(seed)
+}
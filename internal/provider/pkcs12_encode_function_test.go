@@ -0,0 +1,149 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestPKCS12EncodeFunction_Known(t *testing.T) {
+	certPEM, keyPEM, _ := createTestCertificateAndKey(t)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+output "test" {
+  value = provider::appleappstoreconnect::pkcs12_encode(%[1]q, %[2]q, "secret")
+}
+
+output "deterministic" {
+  value = provider::appleappstoreconnect::pkcs12_encode(%[1]q, %[2]q, "secret") == provider::appleappstoreconnect::pkcs12_encode(%[1]q, %[2]q, "secret")
+}
+`, certPEM, keyPEM),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.NotNull()),
+					statecheck.ExpectKnownOutputValue("deterministic", knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestPKCS12EncodeFunctionRun(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, keyPEM, priv := createTestCertificateAndKey(t)
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(string(certPEM)),
+			types.StringValue(keyPEM),
+			types.StringValue("secret"),
+		}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	(&PKCS12EncodeFunction{}).Run(ctx, req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("Expected no error, got: %s", resp.Error)
+	}
+
+	result, ok := resp.Result.Value().(types.String)
+	if !ok {
+		t.Fatalf("Result is %T, want types.String", resp.Result.Value())
+	}
+
+	p12, err := base64.StdEncoding.DecodeString(result.ValueString())
+	if err != nil {
+		t.Fatalf("Result is not valid base64: %v", err)
+	}
+	key, cert, err := pkcs12.Decode(p12, "secret")
+	if err != nil {
+		t.Fatalf("Failed to decode PKCS12 bundle: %v", err)
+	}
+	if !priv.Equal(key) {
+		t.Error("PKCS12 bundle does not contain the given private key")
+	}
+	if cert.SerialNumber.Cmp(big.NewInt(42)) != 0 {
+		t.Errorf("PKCS12 certificate serial = %s, want 42", cert.SerialNumber)
+	}
+}
+
+func TestPKCS12EncodeFunctionRun_Deterministic(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, keyPEM, _ := createTestCertificateAndKey(t)
+
+	run := func(password string) string {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{
+				types.StringValue(string(certPEM)),
+				types.StringValue(keyPEM),
+				types.StringValue(password),
+			}),
+		}
+		resp := &function.RunResponse{
+			Result: function.NewResultData(types.StringUnknown()),
+		}
+
+		(&PKCS12EncodeFunction{}).Run(ctx, req, resp)
+
+		if resp.Error != nil {
+			t.Fatalf("Expected no error, got: %s", resp.Error)
+		}
+		return resp.Result.Value().(types.String).ValueString()
+	}
+
+	first := run("secret")
+	if second := run("secret"); second != first {
+		t.Error("Expected the same bundle for the same arguments")
+	}
+	if other := run("other"); other == first {
+		t.Error("Expected a different bundle for a different password")
+	}
+}
+
+func TestPKCS12EncodeFunctionRun_InvalidKey(t *testing.T) {
+	ctx := context.Background()
+
+	certPEM, _, _ := createTestCertificateAndKey(t)
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(string(certPEM)),
+			types.StringValue("not a private key"),
+			types.StringValue("secret"),
+		}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	(&PKCS12EncodeFunction{}).Run(ctx, req, resp)
+
+	if resp.Error == nil {
+		t.Fatal("Expected error for invalid private key, got nil")
+	}
+}
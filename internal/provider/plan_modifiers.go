@@ -0,0 +1,176 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultRecreateThreshold is the default recreate_threshold in seconds (30 days).
+const defaultRecreateThreshold int64 = 2592000
+
+// RecreateThresholdPlanModifier is a custom plan modifier for an expiration_date attribute
+// that triggers replacement when the resource is within the recreate threshold of expiration.
+// The threshold is read from the recreate_threshold attribute of the same resource.
+type RecreateThresholdPlanModifier struct {
+	// resourceName is used in descriptions and log messages (e.g., "certificate").
+	resourceName string
+}
+
+// NewRecreateThresholdPlanModifier creates a new instance of the plan modifier.
+func NewRecreateThresholdPlanModifier(resourceName string) planmodifier.String {
+	return RecreateThresholdPlanModifier{resourceName: resourceName}
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m RecreateThresholdPlanModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Recreates the %s when it is within the recreate threshold of expiration.", m.resourceName)
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m RecreateThresholdPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modifier logic.
+func (m RecreateThresholdPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// If the resource is being created, don't modify the plan
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// If the resource is being destroyed, don't modify the plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// If the expiration date is not set, don't modify the plan
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	// Get the planned recreate threshold (default to 30 days if not set)
+	var threshold types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("recreate_threshold"), &threshold)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	thresholdSeconds := defaultRecreateThreshold
+	if !threshold.IsNull() && !threshold.IsUnknown() {
+		thresholdSeconds = threshold.ValueInt64()
+	}
+
+	// If threshold is 0, don't recreate
+	if thresholdSeconds == 0 {
+		return
+	}
+
+	// Parse the expiration date
+	expirationStr := req.StateValue.ValueString()
+	if expirationStr == "" {
+		return
+	}
+
+	expirationDate, err := time.Parse("2006-01-02T15:04:05Z", expirationStr)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to parse expiration date", map[string]interface{}{
+			"expiration_date": expirationStr,
+			"error":           err.Error(),
+		})
+		return
+	}
+
+	// Calculate the threshold time
+	thresholdTime := time.Now().Add(time.Duration(thresholdSeconds) * time.Second)
+
+	// If the resource expires within the threshold, require replacement
+	if expirationDate.Before(thresholdTime) {
+		tflog.Info(ctx, "Expiration is within recreate threshold, requiring replacement", map[string]interface{}{
+			"resource":          m.resourceName,
+			"expiration_date":   expirationDate.Format("2006-01-02T15:04:05Z"),
+			"threshold_time":    thresholdTime.Format("2006-01-02T15:04:05Z"),
+			"threshold_seconds": thresholdSeconds,
+		})
+		resp.RequiresReplace = true
+	}
+}
+
+// RecreateThresholdDefaultPlanModifier sets a default value for recreate_threshold.
+type RecreateThresholdDefaultPlanModifier struct{}
+
+// NewRecreateThresholdDefaultPlanModifier creates a new instance of the default plan modifier.
+func NewRecreateThresholdDefaultPlanModifier() planmodifier.Int64 {
+	return RecreateThresholdDefaultPlanModifier{}
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m RecreateThresholdDefaultPlanModifier) Description(ctx context.Context) string {
+	return "Sets default value of 2592000 (30 days) when recreate_threshold is not specified."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m RecreateThresholdDefaultPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Sets default value of 2592000 (30 days) when recreate_threshold is not specified."
+}
+
+// PlanModifyInt64 implements the plan modifier logic.
+func (m RecreateThresholdDefaultPlanModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// If the value is null or unknown, set the default
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.Int64Value(defaultRecreateThreshold)
+	}
+}
+
+// GeneratedPrivateKeyPlanModifier plans private_key_pem for certificates that may generate
+// their own key. A configured value is used as is; otherwise the generated key is kept from
+// state while generate_key is set, and the value is cleared when it is not.
+type GeneratedPrivateKeyPlanModifier struct{}
+
+// NewGeneratedPrivateKeyPlanModifier creates a new instance of the plan modifier.
+func NewGeneratedPrivateKeyPlanModifier() planmodifier.String {
+	return GeneratedPrivateKeyPlanModifier{}
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m GeneratedPrivateKeyPlanModifier) Description(ctx context.Context) string {
+	return "Keeps the generated private key from state while generate_key is set."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m GeneratedPrivateKeyPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Keeps the generated private key from state while `generate_key` is set."
+}
+
+// PlanModifyString implements the plan modifier logic.
+func (m GeneratedPrivateKeyPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// A configured private key is used as is
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var generateKey types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("generate_key"), &generateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without generate_key there is no private key unless one is configured
+	if generateKey.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// Keep the previously generated key; a new key is only generated on create
+	if !req.State.Raw.IsNull() && !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,560 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProfileResource{}
+var _ resource.ResourceWithImportState = &ProfileResource{}
+
+// profileErrorPointers maps JSON:API error source pointers to resource attributes.
+var profileErrorPointers = map[string]path.Path{
+	"/data/attributes/name":            path.Root("name"),
+	"/data/attributes/profileType":     path.Root("profile_type"),
+	"/data/relationships/bundleId":     path.Root("bundle_id"),
+	"/data/relationships/certificates": path.Root("certificate_ids"),
+	"/data/relationships/devices":      path.Root("device_ids"),
+}
+
+// NewProfileResource creates a new provisioning profile resource.
+func NewProfileResource() resource.Resource {
+	return &ProfileResource{}
+}
+
+// ProfileResource defines the resource implementation.
+type ProfileResource struct {
+	client *Client
+}
+
+// ProfileResourceModel describes the resource data model.
+type ProfileResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ProfileType       types.String `tfsdk:"profile_type"`
+	BundleID          types.String `tfsdk:"bundle_id"`
+	CertificateIDs    types.Set    `tfsdk:"certificate_ids"`
+	DeviceIDs         types.Set    `tfsdk:"device_ids"`
+	Platform          types.String `tfsdk:"platform"`
+	ProfileState      types.String `tfsdk:"profile_state"`
+	ProfileContent    types.String `tfsdk:"profile_content"`
+	UUID              types.String `tfsdk:"uuid"`
+	TeamID            types.String `tfsdk:"team_id"`
+	ExpirationDate    types.String `tfsdk:"expiration_date"`
+	RecreateThreshold types.Int64  `tfsdk:"recreate_threshold"`
+}
+
+func (r *ProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profile"
+}
+
+func (r *ProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a provisioning profile in App Store Connect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the provisioning profile.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the provisioning profile.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profile_type": schema.StringAttribute{
+				MarkdownDescription: "The type of provisioning profile. Valid values are: `IOS_APP_DEVELOPMENT`, `IOS_APP_STORE`, `IOS_APP_ADHOC`, `IOS_APP_INHOUSE`, `MAC_APP_DEVELOPMENT`, `MAC_APP_STORE`, `MAC_APP_DIRECT`, `TVOS_APP_DEVELOPMENT`, `TVOS_APP_STORE`, `TVOS_APP_ADHOC`, `TVOS_APP_INHOUSE`, `MAC_CATALYST_APP_DEVELOPMENT`, `MAC_CATALYST_APP_STORE`, `MAC_CATALYST_APP_DIRECT`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(profileTypes...),
+				},
+			},
+			"bundle_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Bundle ID the profile is for (the resource ID, not the bundle identifier).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the certificates to include in the profile. Changing the set regenerates the profile.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"device_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the devices to include in the profile. Required for development and ad hoc profiles. Changing the set regenerates the profile.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "The platform of the provisioning profile.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_state": schema.StringAttribute{
+				MarkdownDescription: "The state of the provisioning profile (`ACTIVE` or `INVALID`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_content": schema.StringAttribute{
+				MarkdownDescription: "The provisioning profile content in base64 encoded format, suitable for writing to a `.mobileprovision` or `.provisionprofile` file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the provisioning profile, decoded from the profile content.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The team identifier of the provisioning profile, decoded from the profile content.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				MarkdownDescription: "The expiration date of the provisioning profile, decoded from the profile content.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					NewRecreateThresholdPlanModifier("profile"),
+				},
+			},
+			"recreate_threshold": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds before profile expiration when Terraform should regenerate the profile. Set to 0 to disable automatic regeneration. Default is 2592000 seconds (30 days).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					NewRecreateThresholdDefaultPlanModifier(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var certificateIDs []string
+	resp.Diagnostics.Append(data.CertificateIDs.ElementsAs(ctx, &certificateIDs, false)...)
+
+	var deviceIDs []string
+	if !data.DeviceIDs.IsNull() {
+		resp.Diagnostics.Append(data.DeviceIDs.ElementsAs(ctx, &deviceIDs, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the request
+	createReq := ProfileCreateRequest{
+		Data: ProfileCreateRequestData{
+			Type: "profiles",
+			Attributes: ProfileCreateRequestAttributes{
+				Name:        data.Name.ValueString(),
+				ProfileType: data.ProfileType.ValueString(),
+			},
+			Relationships: ProfileCreateRequestRelationships{
+				BundleID: ProfileToOneRelationship{
+					Data: RelationshipData{
+						Type: "bundleIds",
+						ID:   data.BundleID.ValueString(),
+					},
+				},
+				Certificates: ProfileToManyRelationship{
+					Data: relationshipDataList("certificates", certificateIDs),
+				},
+			},
+		},
+	}
+
+	if len(deviceIDs) > 0 {
+		createReq.Data.Relationships.Devices = &ProfileToManyRelationship{
+			Data: relationshipDataList("devices", deviceIDs),
+		}
+	}
+
+	tflog.Debug(ctx, "Creating provisioning profile", map[string]interface{}{
+		"name":         data.Name.ValueString(),
+		"profile_type": data.ProfileType.ValueString(),
+		"bundle_id":    data.BundleID.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodPost,
+		Endpoint: "/profiles",
+		Body:     createReq,
+	})
+	if err != nil {
+		addClientErrorDiagnostic(&resp.Diagnostics, "Unable to create provisioning profile", err, profileErrorPointers)
+		return
+	}
+
+	// Parse the response
+	var profile Profile
+	if err := json.Unmarshal(apiResp.Data, &profile); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse provisioning profile response, got error: %s", err),
+		)
+		return
+	}
+
+	// Validate that we got an ID from the API
+	if profile.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid API Response",
+			"The API response did not contain a valid ID for the created provisioning profile",
+		)
+		return
+	}
+
+	data.ID = types.StringValue(profile.ID)
+	resp.Diagnostics.Append(updateProfileModel(&data, &profile)...)
+
+	// Set default recreate_threshold if not provided
+	if data.RecreateThreshold.IsNull() || data.RecreateThreshold.IsUnknown() {
+		data.RecreateThreshold = types.Int64Value(defaultRecreateThreshold)
+	}
+
+	tflog.Trace(ctx, "Created provisioning profile", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading provisioning profile", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Make the API request
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/profiles/%s", data.ID.ValueString()),
+	})
+	if err != nil {
+		if removeResourceIfNotFound(ctx, resp, err, "Provisioning Profile", data.ID.ValueString()) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read provisioning profile, got error: %s", err),
+		)
+		return
+	}
+
+	// Parse the response
+	var profile Profile
+	if err := json.Unmarshal(apiResp.Data, &profile); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse provisioning profile response, got error: %s", err),
+		)
+		return
+	}
+
+	data.Name = types.StringValue(profile.Attributes.Name)
+	data.ProfileType = types.StringValue(profile.Attributes.ProfileType)
+	resp.Diagnostics.Append(updateProfileModel(&data, &profile)...)
+
+	// Refresh the relationships so that drift and imports are detected
+	bundleID, err := r.readToOneRelationship(ctx, data.ID.ValueString(), "bundleId")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read provisioning profile Bundle ID, got error: %s", err),
+		)
+		return
+	}
+	data.BundleID = types.StringValue(bundleID)
+
+	certificateIDs, err := r.readToManyRelationship(ctx, data.ID.ValueString(), "certificates")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read provisioning profile certificates, got error: %s", err),
+		)
+		return
+	}
+
+	certificates, diags := types.SetValueFrom(ctx, types.StringType, certificateIDs)
+	resp.Diagnostics.Append(diags...)
+	data.CertificateIDs = certificates
+
+	deviceIDs, err := r.readToManyRelationship(ctx, data.ID.ValueString(), "devices")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read provisioning profile devices, got error: %s", err),
+		)
+		return
+	}
+
+	// Profiles without devices keep device_ids unset
+	if len(deviceIDs) > 0 || !data.DeviceIDs.IsNull() {
+		devices, diags := types.SetValueFrom(ctx, types.StringType, deviceIDs)
+		resp.Diagnostics.Append(diags...)
+		data.DeviceIDs = devices
+	}
+
+	// Set default recreate_threshold if not present (e.g., after import)
+	if data.RecreateThreshold.IsNull() {
+		data.RecreateThreshold = types.Int64Value(defaultRecreateThreshold)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProfileResourceModel
+	var state ProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Profiles cannot be modified through the API; every argument except
+	// recreate_threshold requires replacement, so only the threshold changes here.
+	state.RecreateThreshold = plan.RecreateThreshold
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting provisioning profile", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Make the API request
+	_, err := r.client.Do(ctx, Request{
+		Method:   http.MethodDelete,
+		Endpoint: fmt.Sprintf("/profiles/%s", data.ID.ValueString()),
+	})
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete provisioning profile, got error: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted provisioning profile", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *ProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readToOneRelationship returns the ID linked by a to-one relationship of a profile.
+func (r *ProfileResource) readToOneRelationship(ctx context.Context, id, relationship string) (string, error) {
+	apiResp, err := r.client.Do(ctx, Request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/profiles/%s/relationships/%s", id, relationship),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var linkage RelationshipData
+	if err := json.Unmarshal(apiResp.Data, &linkage); err != nil {
+		return "", fmt.Errorf("unable to parse %s relationship: %w", relationship, err)
+	}
+
+	return linkage.ID, nil
+}
+
+// readToManyRelationship returns the sorted IDs linked by a to-many relationship of a
+// profile, following every page of results.
+func (r *ProfileResource) readToManyRelationship(ctx context.Context, id, relationship string) ([]string, error) {
+	apiResp, err := r.client.DoAll(ctx, Request{
+		Endpoint: fmt.Sprintf("/profiles/%s/relationships/%s", id, relationship),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var linkages []RelationshipData
+	if err := json.Unmarshal(apiResp.Data, &linkages); err != nil {
+		return nil, fmt.Errorf("unable to parse %s relationship: %w", relationship, err)
+	}
+
+	ids := make([]string, 0, len(linkages))
+	for _, linkage := range linkages {
+		ids = append(ids, linkage.ID)
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+// updateProfileModel updates the computed attributes of the model from the API profile,
+// decoding the UUID, team and expiration date from the profile content.
+func updateProfileModel(model *ProfileResourceModel, profile *Profile) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Platform = types.StringValue(profile.Attributes.Platform)
+	model.ProfileState = types.StringValue(profile.Attributes.ProfileState)
+	model.ProfileContent = types.StringValue(profile.Attributes.ProfileContent)
+
+	// Fall back to the API attributes if the content cannot be decoded
+	model.UUID = types.StringValue(profile.Attributes.UUID)
+	model.TeamID = types.StringNull()
+	model.ExpirationDate = types.StringNull()
+	if profile.Attributes.ExpirationDate != nil {
+		model.ExpirationDate = types.StringValue(profile.Attributes.ExpirationDate.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+
+	if profile.Attributes.ProfileContent == "" {
+		return diags
+	}
+
+	info, err := parseProvisioningProfile(profile.Attributes.ProfileContent)
+	if err != nil {
+		diags.AddWarning(
+			"Profile Content Error",
+			fmt.Sprintf("Unable to decode provisioning profile content, using API attributes instead: %s", err),
+		)
+		return diags
+	}
+
+	model.UUID = types.StringValue(info.UUID)
+	if info.TeamID != "" {
+		model.TeamID = types.StringValue(info.TeamID)
+	}
+	if !info.ExpirationDate.IsZero() {
+		model.ExpirationDate = types.StringValue(info.ExpirationDate.Format("2006-01-02T15:04:05Z"))
+	}
+
+	return diags
+}
+
+// relationshipDataList builds relationship linkage data of the given type for ids.
+func relationshipDataList(resourceType string, ids []string) []RelationshipData {
+	data := make([]RelationshipData, 0, len(ids))
+	for _, id := range ids {
+		data = append(data, RelationshipData{
+			Type: resourceType,
+			ID:   id,
+		})
+	}
+	return data
+}
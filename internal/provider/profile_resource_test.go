@@ -0,0 +1,179 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProfileResource(t *testing.T) {
+	// Profiles need an existing distribution certificate, which cannot be created
+	// without a real CSR and private key.
+	certificateID := os.Getenv("APP_STORE_CONNECT_TEST_CERTIFICATE_ID")
+	if certificateID == "" {
+		t.Skip("APP_STORE_CONNECT_TEST_CERTIFICATE_ID must be set to an IOS_DISTRIBUTION certificate ID")
+	}
+
+	identifier := fmt.Sprintf("io.truetickets.test.profile%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProfileResourceConfig(identifier, certificateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appleappstoreconnect_profile.test", "profile_type", ProfileTypeIOSAppStore),
+					resource.TestCheckResourceAttr("appleappstoreconnect_profile.test", "certificate_ids.#", "1"),
+					resource.TestCheckResourceAttr("appleappstoreconnect_profile.test", "recreate_threshold", "2592000"),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_profile.test", "id"),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_profile.test", "profile_content"),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_profile.test", "uuid"),
+					resource.TestCheckResourceAttrSet("appleappstoreconnect_profile.test", "expiration_date"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "appleappstoreconnect_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProfileResourceConfig(identifier, certificateID string) string {
+	return fmt.Sprintf(`
+resource "appleappstoreconnect_bundle_id" "test" {
+  identifier = %[1]q
+  name       = "Test Profile Bundle ID"
+  platform   = "IOS"
+}
+
+resource "appleappstoreconnect_profile" "test" {
+  name            = %[1]q
+  profile_type    = "IOS_APP_STORE"
+  bundle_id       = appleappstoreconnect_bundle_id.test.id
+  certificate_ids = [%[2]q]
+}
+`, identifier, certificateID)
+}
+
+func TestProfileResourceRead(t *testing.T) {
+	ctx := context.Background()
+	content := testProfileContent(testProfilePlist)
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/profiles/PROFILE1":
+			_, _ = fmt.Fprintf(w, `{"data":{"type":"profiles","id":"PROFILE1","attributes":{"name":"True Tickets App Store","platform":"IOS","profileContent":%q,"uuid":"api-uuid","profileState":"ACTIVE","profileType":"IOS_APP_STORE","expirationDate":"2026-01-01T00:00:00.000+00:00"}}}`, content)
+		case "/v1/profiles/PROFILE1/relationships/bundleId":
+			_, _ = w.Write([]byte(`{"data":{"type":"bundleIds","id":"BUNDLE1"}}`))
+		case "/v1/profiles/PROFILE1/relationships/certificates":
+			_, _ = w.Write([]byte(`{"data":[{"type":"certificates","id":"CERT2"},{"type":"certificates","id":"CERT1"}]}`))
+		case "/v1/profiles/PROFILE1/relationships/devices":
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := &ProfileResource{client: client}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("id"), "PROFILE1")
+	if diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	var got ProfileResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to get state: %v", resp.Diagnostics)
+	}
+
+	if got.UUID.ValueString() != "3f1b8c3e-5a7d-4e2b-9c1a-0d2e4f6a8b9c" {
+		t.Errorf("uuid = %q, want the UUID decoded from the profile content", got.UUID.ValueString())
+	}
+	if got.TeamID.ValueString() != "ABCDE12345" {
+		t.Errorf("team_id = %q, want %q", got.TeamID.ValueString(), "ABCDE12345")
+	}
+	if got.ExpirationDate.ValueString() != "2026-01-15T10:00:00Z" {
+		t.Errorf("expiration_date = %q, want %q", got.ExpirationDate.ValueString(), "2026-01-15T10:00:00Z")
+	}
+	if got.BundleID.ValueString() != "BUNDLE1" {
+		t.Errorf("bundle_id = %q, want %q", got.BundleID.ValueString(), "BUNDLE1")
+	}
+
+	wantCertificates, _ := types.SetValueFrom(ctx, types.StringType, []string{"CERT1", "CERT2"})
+	if !got.CertificateIDs.Equal(wantCertificates) {
+		t.Errorf("certificate_ids = %s, want %s", got.CertificateIDs, wantCertificates)
+	}
+	if !got.DeviceIDs.IsNull() {
+		t.Errorf("device_ids = %s, want null", got.DeviceIDs)
+	}
+	if got.RecreateThreshold.ValueInt64() != defaultRecreateThreshold {
+		t.Errorf("recreate_threshold = %d, want %d", got.RecreateThreshold.ValueInt64(), defaultRecreateThreshold)
+	}
+}
+
+func TestProfileResourceRead_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"There is no resource of type 'profiles' with id 'PROFILE1'"}]}`))
+	})
+
+	r := &ProfileResource{client: client}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("id"), "PROFILE1")
+	if diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
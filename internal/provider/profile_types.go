@@ -0,0 +1,99 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+)
+
+// Profile represents a provisioning profile in the App Store Connect API.
+type Profile struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Attributes ProfileAttributes `json:"attributes"`
+	Links      ResourceLinks     `json:"links,omitempty"`
+}
+
+// ProfileAttributes represents the attributes of a provisioning profile.
+type ProfileAttributes struct {
+	Name           string     `json:"name"`
+	Platform       string     `json:"platform,omitempty"`
+	ProfileContent string     `json:"profileContent,omitempty"`
+	UUID           string     `json:"uuid,omitempty"`
+	CreatedDate    *time.Time `json:"createdDate,omitempty"`
+	ProfileState   string     `json:"profileState,omitempty"`
+	ProfileType    string     `json:"profileType"`
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+}
+
+// ProfileCreateRequest represents the request body for creating a provisioning profile.
+type ProfileCreateRequest struct {
+	Data ProfileCreateRequestData `json:"data"`
+}
+
+// ProfileCreateRequestData represents the data for creating a provisioning profile.
+type ProfileCreateRequestData struct {
+	Type          string                            `json:"type"`
+	Attributes    ProfileCreateRequestAttributes    `json:"attributes"`
+	Relationships ProfileCreateRequestRelationships `json:"relationships"`
+}
+
+// ProfileCreateRequestAttributes represents the attributes for creating a provisioning profile.
+type ProfileCreateRequestAttributes struct {
+	Name        string `json:"name"`
+	ProfileType string `json:"profileType"`
+}
+
+// ProfileCreateRequestRelationships represents the relationships for creating a provisioning profile.
+type ProfileCreateRequestRelationships struct {
+	BundleID     ProfileToOneRelationship   `json:"bundleId"`
+	Certificates ProfileToManyRelationship  `json:"certificates"`
+	Devices      *ProfileToManyRelationship `json:"devices,omitempty"`
+}
+
+// ProfileToOneRelationship represents a to-one relationship in the create request.
+type ProfileToOneRelationship struct {
+	Data RelationshipData `json:"data"`
+}
+
+// ProfileToManyRelationship represents a to-many relationship in the create request.
+type ProfileToManyRelationship struct {
+	Data []RelationshipData `json:"data"`
+}
+
+// Profile type constants.
+const (
+	ProfileTypeIOSAppDevelopment         = "IOS_APP_DEVELOPMENT"
+	ProfileTypeIOSAppStore               = "IOS_APP_STORE"
+	ProfileTypeIOSAppAdHoc               = "IOS_APP_ADHOC"
+	ProfileTypeIOSAppInHouse             = "IOS_APP_INHOUSE"
+	ProfileTypeMacAppDevelopment         = "MAC_APP_DEVELOPMENT"
+	ProfileTypeMacAppStore               = "MAC_APP_STORE"
+	ProfileTypeMacAppDirect              = "MAC_APP_DIRECT"
+	ProfileTypeTVOSAppDevelopment        = "TVOS_APP_DEVELOPMENT"
+	ProfileTypeTVOSAppStore              = "TVOS_APP_STORE"
+	ProfileTypeTVOSAppAdHoc              = "TVOS_APP_ADHOC"
+	ProfileTypeTVOSAppInHouse            = "TVOS_APP_INHOUSE"
+	ProfileTypeMacCatalystAppDevelopment = "MAC_CATALYST_APP_DEVELOPMENT"
+	ProfileTypeMacCatalystAppStore       = "MAC_CATALYST_APP_STORE"
+	ProfileTypeMacCatalystAppDirect      = "MAC_CATALYST_APP_DIRECT"
+)
+
+// profileTypes lists every profile type accepted by the API.
+var profileTypes = []string{
+	ProfileTypeIOSAppDevelopment,
+	ProfileTypeIOSAppStore,
+	ProfileTypeIOSAppAdHoc,
+	ProfileTypeIOSAppInHouse,
+	ProfileTypeMacAppDevelopment,
+	ProfileTypeMacAppStore,
+	ProfileTypeMacAppDirect,
+	ProfileTypeTVOSAppDevelopment,
+	ProfileTypeTVOSAppStore,
+	ProfileTypeTVOSAppAdHoc,
+	ProfileTypeTVOSAppInHouse,
+	ProfileTypeMacCatalystAppDevelopment,
+	ProfileTypeMacCatalystAppStore,
+	ProfileTypeMacCatalystAppDirect,
+}
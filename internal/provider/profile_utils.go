@@ -0,0 +1,134 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// provisioningProfileInfo holds the fields decoded from a provisioning profile's plist.
+type provisioningProfileInfo struct {
+	UUID           string
+	TeamID         string
+	ExpirationDate time.Time
+}
+
+// parseProvisioningProfile decodes a base64 encoded provisioning profile and extracts
+// fields from its property list.
+//
+// A provisioning profile is a CMS signed message whose content is an XML property list.
+// Apple encodes the envelope with BER indefinite lengths, which encoding/asn1 cannot
+// parse, so the plist is located by its XML markers instead.
+func parseProvisioningProfile(base64Content string) (*provisioningProfileInfo, error) {
+	content, err := base64.StdEncoding.DecodeString(base64Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 profile: %w", err)
+	}
+
+	start := bytes.Index(content, []byte("<?xml"))
+	end := bytes.LastIndex(content, []byte("</plist>"))
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("profile does not contain a property list")
+	}
+
+	return parseProfilePlist(content[start : end+len("</plist>")])
+}
+
+// parseProfilePlist extracts the fields of interest from the top-level dictionary of
+// a provisioning profile property list.
+func parseProfilePlist(plist []byte) (*provisioningProfileInfo, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(plist))
+	info := &provisioningProfileInfo{}
+
+	// Advance to the top-level dictionary
+	if err := seekPlistElement(decoder, "dict"); err != nil {
+		return nil, err
+	}
+
+	var key string
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse profile plist: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			if t.Name.Local == "dict" {
+				if info.UUID == "" {
+					return nil, fmt.Errorf("profile plist does not contain a UUID")
+				}
+				return info, nil
+			}
+		case xml.StartElement:
+			if t.Name.Local == "key" {
+				if err := decoder.DecodeElement(&key, &t); err != nil {
+					return nil, fmt.Errorf("failed to parse profile plist key: %w", err)
+				}
+				continue
+			}
+
+			if err := decodeProfilePlistValue(decoder, t, key, info); err != nil {
+				return nil, err
+			}
+			key = ""
+		}
+	}
+}
+
+// decodeProfilePlistValue decodes the value for key into info, skipping values that are
+// not needed.
+func decodeProfilePlistValue(decoder *xml.Decoder, start xml.StartElement, key string, info *provisioningProfileInfo) error {
+	switch {
+	case key == "UUID" && start.Name.Local == "string":
+		return decoder.DecodeElement(&info.UUID, &start)
+	case key == "TeamIdentifier" && start.Name.Local == "array":
+		var teamIDs struct {
+			Values []string `xml:"string"`
+		}
+		if err := decoder.DecodeElement(&teamIDs, &start); err != nil {
+			return fmt.Errorf("failed to parse profile team identifier: %w", err)
+		}
+		if len(teamIDs.Values) > 0 {
+			info.TeamID = teamIDs.Values[0]
+		}
+		return nil
+	case key == "ExpirationDate" && start.Name.Local == "date":
+		var value string
+		if err := decoder.DecodeElement(&value, &start); err != nil {
+			return fmt.Errorf("failed to parse profile expiration date: %w", err)
+		}
+		date, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("failed to parse profile expiration date: %w", err)
+		}
+		info.ExpirationDate = date.UTC()
+		return nil
+	default:
+		return decoder.Skip()
+	}
+}
+
+// seekPlistElement advances decoder past the start of the first element named name.
+func seekPlistElement(decoder *xml.Decoder, name string) error {
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return fmt.Errorf("profile plist does not contain a %s element", name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse profile plist: %w", err)
+		}
+
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == name {
+			return nil
+		}
+	}
+}
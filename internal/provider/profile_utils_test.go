@@ -0,0 +1,92 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testProfilePlist is a trimmed down provisioning profile property list.
+const testProfilePlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>AppIDName</key>
+	<string>True Tickets</string>
+	<key>ApplicationIdentifierPrefix</key>
+	<array>
+		<string>ABCDE12345</string>
+	</array>
+	<key>CreationDate</key>
+	<date>2025-01-15T10:00:00Z</date>
+	<key>Entitlements</key>
+	<dict>
+		<key>application-identifier</key>
+		<string>ABCDE12345.io.truetickets.app</string>
+		<key>UUID</key>
+		<string>nested-value-must-be-ignored</string>
+	</dict>
+	<key>ExpirationDate</key>
+	<date>2026-01-15T10:00:00Z</date>
+	<key>Name</key>
+	<string>True Tickets App Store</string>
+	<key>TeamIdentifier</key>
+	<array>
+		<string>ABCDE12345</string>
+	</array>
+	<key>UUID</key>
+	<string>3f1b8c3e-5a7d-4e2b-9c1a-0d2e4f6a8b9c</string>
+	<key>Version</key>
+	<integer>1</integer>
+</dict>
+</plist>`
+
+// testProfileContent wraps the plist in bytes resembling a CMS envelope.
+func testProfileContent(plist string) string {
+	envelope := "\x30\x80\x06\x09\x2a\x86\x48\x86\xf7\x0d\x01\x07\x02\xa0\x80" + plist + "\x00\x00\xa0\x82\x01\x00"
+	return base64.StdEncoding.EncodeToString([]byte(envelope))
+}
+
+func TestParseProvisioningProfile(t *testing.T) {
+	info, err := parseProvisioningProfile(testProfileContent(testProfilePlist))
+	if err != nil {
+		t.Fatalf("parseProvisioningProfile() error = %v", err)
+	}
+
+	if info.UUID != "3f1b8c3e-5a7d-4e2b-9c1a-0d2e4f6a8b9c" {
+		t.Errorf("UUID = %q, want %q", info.UUID, "3f1b8c3e-5a7d-4e2b-9c1a-0d2e4f6a8b9c")
+	}
+
+	if info.TeamID != "ABCDE12345" {
+		t.Errorf("TeamID = %q, want %q", info.TeamID, "ABCDE12345")
+	}
+
+	want := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	if !info.ExpirationDate.Equal(want) {
+		t.Errorf("ExpirationDate = %v, want %v", info.ExpirationDate, want)
+	}
+}
+
+func TestParseProvisioningProfile_InvalidBase64(t *testing.T) {
+	if _, err := parseProvisioningProfile("not-valid-base64!@#$"); err == nil {
+		t.Error("Expected error for invalid base64")
+	}
+}
+
+func TestParseProvisioningProfile_NoPlist(t *testing.T) {
+	content := base64.StdEncoding.EncodeToString([]byte("\x30\x80\x06\x09 no plist here"))
+	if _, err := parseProvisioningProfile(content); err == nil {
+		t.Error("Expected error for content without a property list")
+	}
+}
+
+func TestParseProvisioningProfile_MissingUUID(t *testing.T) {
+	plist := strings.Replace(testProfilePlist, "<key>UUID</key>\n\t<string>3f1b8c3e-5a7d-4e2b-9c1a-0d2e4f6a8b9c</string>", "", 1)
+	if _, err := parseProvisioningProfile(testProfileContent(plist)); err == nil {
+		t.Error("Expected error for property list without a UUID")
+	}
+}
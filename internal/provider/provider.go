@@ -7,13 +7,23 @@ import (
 	"context"
 	"fmt"
 	"os"
-
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,6 +31,7 @@ import (
 // Ensure AppleAppStoreConnectProvider satisfies various provider interfaces.
 var _ provider.Provider = &AppleAppStoreConnectProvider{}
 var _ provider.ProviderWithFunctions = &AppleAppStoreConnectProvider{}
+var _ provider.ProviderWithEphemeralResources = &AppleAppStoreConnectProvider{}
 
 // AppleAppStoreConnectProvider defines the provider implementation.
 type AppleAppStoreConnectProvider struct {
@@ -32,9 +43,28 @@ type AppleAppStoreConnectProvider struct {
 
 // AppleAppStoreConnectProviderModel describes the provider data model.
 type AppleAppStoreConnectProviderModel struct {
-	IssuerID   types.String `tfsdk:"issuer_id"`
-	KeyID      types.String `tfsdk:"key_id"`
-	PrivateKey types.String `tfsdk:"private_key"`
+	Profile        types.String `tfsdk:"profile"`
+	ProfilesFile   types.String `tfsdk:"profiles_file"`
+	AuthMode       types.String `tfsdk:"auth_mode"`
+	IssuerID       types.String `tfsdk:"issuer_id"`
+	KeyID          types.String `tfsdk:"key_id"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	PrivateKeyPath types.String `tfsdk:"private_key_path"`
+	SigningCommand types.List   `tfsdk:"signing_command"`
+	AccessToken    types.String `tfsdk:"access_token"`
+	TokenLifetime  types.Int64  `tfsdk:"token_lifetime"`
+	TokenScopes    types.List   `tfsdk:"token_scopes"`
+	MaxPages       types.Int64  `tfsdk:"max_pages"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait   types.Int64  `tfsdk:"retry_max_wait"`
+
+	RateLimitWarningThreshold types.Int64 `tfsdk:"rate_limit_warning_threshold"`
+
+	Endpoint           types.String `tfsdk:"endpoint"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CABundleFile       types.String `tfsdk:"ca_bundle_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *AppleAppStoreConnectProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,8 +76,25 @@ func (p *AppleAppStoreConnectProvider) Schema(ctx context.Context, req provider.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Apple App Store Connect provider allows you to manage App Store Connect resources such as Pass Type IDs and Certificates.",
 		Attributes: map[string]schema.Attribute{
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The name of a profile in the profiles file to read `auth_mode`, `issuer_id`, `key_id`, `private_key` and `private_key_path` from. " +
+					"Attributes set in the configuration take precedence over the profile, and the credential environment variables are ignored when a profile is selected. " +
+					"Can also be set via the `APP_STORE_CONNECT_PROFILE` environment variable.",
+				Optional: true,
+			},
+			"profiles_file": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The path to the profiles file, in INI or JSON format. Only read when a profile is selected. Can also be set via the `APP_STORE_CONNECT_PROFILES_FILE` environment variable. Defaults to `%s`.", defaultProfilesFile),
+				Optional:            true,
+			},
+			"auth_mode": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The kind of API key used for authentication. Valid values are: `%s` for team API keys, and `%s` for individual API keys, which are tied to a single user and have no issuer ID. Defaults to `%s`.", AuthModeTeam, AuthModeIndividual, AuthModeTeam),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(AuthModeTeam, AuthModeIndividual),
+				},
+			},
 			"issuer_id": schema.StringAttribute{
-				MarkdownDescription: "The issuer ID from the API keys page in App Store Connect. Can also be set via the `APP_STORE_CONNECT_ISSUER_ID` environment variable.",
+				MarkdownDescription: "The issuer ID from the API keys page in App Store Connect. Required for team API keys and ignored when `auth_mode` is `individual`. Can also be set via the `APP_STORE_CONNECT_ISSUER_ID` environment variable.",
 				Optional:            true,
 			},
 			"key_id": schema.StringAttribute{
@@ -55,9 +102,113 @@ func (p *AppleAppStoreConnectProvider) Schema(ctx context.Context, req provider.
 				Optional:            true,
 			},
 			"private_key": schema.StringAttribute{
-				MarkdownDescription: "The private key contents (.p8 file) for App Store Connect API authentication. Can also be set via the `APP_STORE_CONNECT_PRIVATE_KEY` environment variable.",
+				MarkdownDescription: "The private key contents (.p8 file) for App Store Connect API authentication. Accepts the PEM file as downloaded, base64 encoded PEM, or PKCS #8 DER. The key must be an ECDSA P-256 key. Can also be set via the `APP_STORE_CONNECT_PRIVATE_KEY` environment variable. Conflicts with `private_key_path`.",
 				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key_path")),
+				},
+			},
+			"private_key_path": schema.StringAttribute{
+				MarkdownDescription: "The path to the private key file (.p8 file) for App Store Connect API authentication, in any of the formats accepted by `private_key`. Can also be set via the `APP_STORE_CONNECT_PRIVATE_KEY_PATH` environment variable, which is only used when no private key is configured.",
+				Optional:            true,
+			},
+			"signing_command": schema.ListAttribute{
+				MarkdownDescription: "An executable followed by its arguments that signs API tokens, so that the private key can be held in a KMS or HSM instead of the configuration. " +
+					"The command receives the JWT signing input on stdin and must write its ES256 signature to stdout, either as the raw 64 byte R || S value or as an ASN.1 DER signature such as `openssl dgst -sha256 -sign` produces, optionally base64 encoded. " +
+					"When set, no private key is read from the configuration or environment. Conflicts with `private_key` and `private_key_path`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					listvalidator.ConflictsWith(path.MatchRoot("private_key"), path.MatchRoot("private_key_path")),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "A JWT minted elsewhere that is sent with every request instead of tokens generated by the provider. The provider cannot refresh it, so it must remain valid for the whole run. " +
+					"When set, `issuer_id`, `key_id` and the private key are not required. Can also be set via the `APP_STORE_CONNECT_ACCESS_TOKEN` environment variable, which is only used when no private key or signing command is configured.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("private_key"),
+						path.MatchRoot("private_key_path"),
+						path.MatchRoot("signing_command"),
+						path.MatchRoot("token_lifetime"),
+						path.MatchRoot("token_scopes"),
+					),
+				},
+			},
+			"token_lifetime": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The lifetime of generated tokens in seconds. Apple rejects tokens valid for more than 20 minutes. Tokens are refreshed shortly before they expire. Defaults to %d.", int64(maxTokenLifetime/time.Second)),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(maxTokenLifetime/time.Second)),
+				},
+			},
+			"token_scopes": schema.ListAttribute{
+				MarkdownDescription: "Restricts generated tokens to the given requests, such as `GET /v1/certificates` or `GET /v1/devices?filter[platform]=IOS`. " +
+					"Scoped tokens can only be used for these GET requests, so resources that create, update or delete objects fail with a scoped token.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^GET /v1/\S+$`), "must be a GET request path such as \"GET /v1/certificates\""),
+					),
+				},
+			},
+			"max_pages": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of pages of %d items to follow when listing resources. Data sources fail rather than return partial results when this limit is exceeded. Defaults to %d.", pageLimit, defaultMaxPages),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of times a failed API request is retried. Rate limited (HTTP 429) responses are retried for every request; server errors and connection failures are only retried for idempotent requests (GET, PUT, DELETE). Set to 0 to disable retries. Defaults to %d.", defaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of seconds to wait between retries. Retries back off exponentially with jitter, or wait as long as the `Retry-After` response header asks, up to this limit. Defaults to %d.", int64(defaultRetryMaxWait/time.Second)),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rate_limit_warning_threshold": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The remaining hourly request budget, as reported by the `X-Rate-Limit` response header, below which a warning is logged. Requests are always throttled client-side once the budget is exhausted. Defaults to %d%% of the hourly limit.", defaultRateLimitWarningPercent),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The base URL of the App Store Connect API, including the version path. Use this to point the provider at a local stand-in during integration testing. Can also be set via the `APP_STORE_CONNECT_ENDPOINT` environment variable. Defaults to `%s`.", defaultBaseURL),
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of seconds a single API request may take, including reading the response. Each retry gets its own timeout. Defaults to %d.", int64(defaultRequestTimeout/time.Second)),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "The URL of an HTTP proxy to send API requests through, such as `http://proxy.example.com:3128`. When not set, the standard `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used.",
+				Optional:            true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM file of CA certificates to trust in addition to the system roots, such as the certificate of a TLS-inspecting egress proxy.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable TLS certificate verification for API requests. Only use this when testing against a local stand-in of the API. Defaults to `false`.",
+				Optional:            true,
 			},
 		},
 	}
@@ -75,6 +226,33 @@ func (p *AppleAppStoreConnectProvider) Configure(ctx context.Context, req provid
 	}
 
 	// Check if any configuration values are unknown
+	if data.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown Apple App Store Connect Profile",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APP_STORE_CONNECT_PROFILE environment variable.",
+		)
+	}
+
+	if data.ProfilesFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profiles_file"),
+			"Unknown Apple App Store Connect Profiles File",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the profiles file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APP_STORE_CONNECT_PROFILES_FILE environment variable.",
+		)
+	}
+
+	if data.AuthMode.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_mode"),
+			"Unknown Apple App Store Connect Auth Mode",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the auth mode. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
 	if data.IssuerID.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("issuer_id"),
@@ -102,14 +280,102 @@ func (p *AppleAppStoreConnectProvider) Configure(ctx context.Context, req provid
 		)
 	}
 
+	if data.PrivateKeyPath.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key_path"),
+			"Unknown Apple App Store Connect Private Key Path",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the private key path. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APP_STORE_CONNECT_PRIVATE_KEY_PATH environment variable.",
+		)
+	}
+
+	if data.SigningCommand.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("signing_command"),
+			"Unknown Apple App Store Connect Signing Command",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the signing command. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if data.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown Apple App Store Connect Access Token",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the access token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APP_STORE_CONNECT_ACCESS_TOKEN environment variable.",
+		)
+	}
+
+	if data.TokenScopes.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_scopes"),
+			"Unknown Apple App Store Connect Token Scopes",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the token scopes. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if data.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown Apple App Store Connect Endpoint",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the endpoint. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APP_STORE_CONNECT_ENDPOINT environment variable.",
+		)
+	}
+
+	if data.HTTPProxy.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("http_proxy"),
+			"Unknown HTTP Proxy",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the HTTP proxy. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the HTTPS_PROXY environment variable.",
+		)
+	}
+
+	if data.CABundleFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_bundle_file"),
+			"Unknown CA Bundle File",
+			"The provider cannot create the Apple App Store Connect API client as there is an unknown configuration value for the CA bundle file. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Credentials set in the configuration take precedence over the selected profile,
+	// which takes precedence over the environment variables. The environment is not
+	// consulted when a profile is selected, so credentials of different teams are
+	// never mixed.
+	profile, ok := resolveProfile(&data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Set values from environment variables if not set in configuration
-	issuerID := os.Getenv("APP_STORE_CONNECT_ISSUER_ID")
-	keyID := os.Getenv("APP_STORE_CONNECT_KEY_ID")
-	privateKey := os.Getenv("APP_STORE_CONNECT_PRIVATE_KEY")
+	// privateKeyPathSource names where privateKeyPath was set, for errors reading the file
+	var issuerID, keyID, privateKey, privateKeyPath, privateKeyPathSource, accessToken string
+	authMode := AuthModeTeam
+	if ok {
+		issuerID = profile.IssuerID
+		keyID = profile.KeyID
+		privateKey = profile.PrivateKey
+		privateKeyPath = profile.PrivateKeyPath
+		privateKeyPathSource = fmt.Sprintf("the private_key_path of profile %q", profile.Name)
+		if profile.AuthMode != "" {
+			authMode = profile.AuthMode
+		}
+	} else {
+		issuerID = os.Getenv("APP_STORE_CONNECT_ISSUER_ID")
+		keyID = os.Getenv("APP_STORE_CONNECT_KEY_ID")
+		privateKey = os.Getenv("APP_STORE_CONNECT_PRIVATE_KEY")
+		privateKeyPath = os.Getenv("APP_STORE_CONNECT_PRIVATE_KEY_PATH")
+		privateKeyPathSource = "the APP_STORE_CONNECT_PRIVATE_KEY_PATH environment variable"
+		accessToken = os.Getenv("APP_STORE_CONNECT_ACCESS_TOKEN")
+	}
 
 	if !data.IssuerID.IsNull() {
 		issuerID = data.IssuerID.ValueString()
@@ -119,52 +385,176 @@ func (p *AppleAppStoreConnectProvider) Configure(ctx context.Context, req provid
 		keyID = data.KeyID.ValueString()
 	}
 
-	if !data.PrivateKey.IsNull() {
+	// An access token or a signing command replaces the private key. Otherwise a
+	// configured key or path takes precedence over the profile and environment, and
+	// the key contents take precedence over the path
+	var signingCommand []string
+	switch {
+	case !data.AccessToken.IsNull():
+		accessToken = data.AccessToken.ValueString()
+		privateKey = ""
+		privateKeyPath = ""
+	case !data.SigningCommand.IsNull():
+		resp.Diagnostics.Append(data.SigningCommand.ElementsAs(ctx, &signingCommand, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		accessToken = ""
+		privateKey = ""
+		privateKeyPath = ""
+	case !data.PrivateKey.IsNull():
+		accessToken = ""
 		privateKey = data.PrivateKey.ValueString()
+		privateKeyPath = ""
+	case !data.PrivateKeyPath.IsNull():
+		accessToken = ""
+		privateKey = ""
+		privateKeyPath = data.PrivateKeyPath.ValueString()
+		privateKeyPathSource = "private_key_path"
+	case accessToken != "":
+		privateKey = ""
+		privateKeyPath = ""
+	case privateKey != "":
+		privateKeyPath = ""
 	}
 
-	// Validate required fields
-	if issuerID == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("issuer_id"),
-			"Missing Apple App Store Connect Issuer ID",
-			"The provider cannot create the Apple App Store Connect API client as there is a missing or empty value for the issuer ID. "+
-				"Set the issuer_id value in the configuration or use the APP_STORE_CONNECT_ISSUER_ID environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	if privateKeyPath != "" {
+		contents, err := os.ReadFile(expandHome(privateKeyPath))
+		if err != nil {
+			summary := "Unable to Read Apple App Store Connect Private Key"
+			detail := fmt.Sprintf("The provider cannot read the private key file %q set in %s: %s", privateKeyPath, privateKeyPathSource, err)
+
+			// Only a path from the configuration can be attributed to the attribute
+			if !data.PrivateKeyPath.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("private_key_path"), summary, detail)
+			} else {
+				resp.Diagnostics.AddError(summary, detail)
+			}
+			return
+		}
+		privateKey = string(contents)
 	}
 
-	if keyID == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("key_id"),
-			"Missing Apple App Store Connect Key ID",
-			"The provider cannot create the Apple App Store Connect API client as there is a missing or empty value for the key ID. "+
-				"Set the key_id value in the configuration or use the APP_STORE_CONNECT_KEY_ID environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	if !data.AuthMode.IsNull() {
+		authMode = data.AuthMode.ValueString()
 	}
 
-	if privateKey == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("private_key"),
-			"Missing Apple App Store Connect Private Key",
-			"The provider cannot create the Apple App Store Connect API client as there is a missing or empty value for the private key. "+
-				"Set the private_key value in the configuration or use the APP_STORE_CONNECT_PRIVATE_KEY environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	// Validate required fields; individual API keys have no issuer, and a
+	// pre-supplied access token needs no credentials at all
+	if accessToken == "" {
+		if issuerID == "" && authMode == AuthModeTeam {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("issuer_id"),
+				"Missing Apple App Store Connect Issuer ID",
+				"The provider cannot create the Apple App Store Connect API client as there is a missing or empty value for the issuer ID. "+
+					"Set the issuer_id value in the configuration or use the APP_STORE_CONNECT_ISSUER_ID environment variable. "+
+					"If either is already set, ensure the value is not empty. Individual API keys have no issuer ID; set auth_mode to \"individual\" to use one.",
+			)
+		}
+
+		if keyID == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key_id"),
+				"Missing Apple App Store Connect Key ID",
+				"The provider cannot create the Apple App Store Connect API client as there is a missing or empty value for the key ID. "+
+					"Set the key_id value in the configuration or use the APP_STORE_CONNECT_KEY_ID environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+
+		if privateKey == "" && signingCommand == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Missing Apple App Store Connect Private Key",
+				"The provider cannot create the Apple App Store Connect API client as there is a missing or empty value for the private key. "+
+					"Set the private_key, private_key_path or signing_command value in the configuration, or use the APP_STORE_CONNECT_PRIVATE_KEY or APP_STORE_CONNECT_PRIVATE_KEY_PATH environment variable. "+
+					"If any is already set, ensure the value and the file contents are not empty. Alternatively, supply a token minted elsewhere with access_token.",
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := os.Getenv("APP_STORE_CONNECT_ENDPOINT")
+	if !data.Endpoint.IsNull() && !data.Endpoint.IsUnknown() {
+		endpoint = data.Endpoint.ValueString()
 	}
 
+	if endpoint != "" {
+		if _, err := parseHTTPURL(endpoint); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Invalid Apple App Store Connect Endpoint",
+				fmt.Sprintf("The endpoint must be an absolute http or https URL, such as %s: %s", defaultBaseURL, err),
+			)
+		}
+	}
+
+	transportConfig := resolveTransportConfig(&data, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	tflog.Debug(ctx, "Creating Apple App Store Connect API client", map[string]interface{}{
+		"auth_mode": authMode,
 		"issuer_id": issuerID,
 		"key_id":    keyID,
+		"endpoint":  endpoint,
 	})
 
+	opts := []ClientOption{WithAuthMode(authMode), WithBaseURL(endpoint)}
+	if !data.RequestTimeout.IsNull() && !data.RequestTimeout.IsUnknown() {
+		opts = append(opts, WithRequestTimeout(time.Duration(data.RequestTimeout.ValueInt64())*time.Second))
+	}
+	if !transportConfig.IsZero() {
+		opts = append(opts, WithTransport(newHTTPTransport(transportConfig)))
+	}
+	if !data.MaxPages.IsNull() && !data.MaxPages.IsUnknown() {
+		opts = append(opts, WithMaxPages(int(data.MaxPages.ValueInt64())))
+	}
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		opts = append(opts, WithMaxRetries(int(data.MaxRetries.ValueInt64())))
+	}
+	if !data.RetryMaxWait.IsNull() && !data.RetryMaxWait.IsUnknown() {
+		opts = append(opts, WithRetryMaxWait(time.Duration(data.RetryMaxWait.ValueInt64())*time.Second))
+	}
+	if !data.RateLimitWarningThreshold.IsNull() && !data.RateLimitWarningThreshold.IsUnknown() {
+		opts = append(opts, WithRateLimitWarningThreshold(int(data.RateLimitWarningThreshold.ValueInt64())))
+	}
+
+	if accessToken != "" {
+		opts = append(opts, WithAccessToken(accessToken))
+	}
+	if !data.TokenLifetime.IsNull() && !data.TokenLifetime.IsUnknown() {
+		opts = append(opts, WithTokenLifetime(time.Duration(data.TokenLifetime.ValueInt64())*time.Second))
+	}
+	if !data.TokenScopes.IsNull() {
+		var tokenScopes []string
+		resp.Diagnostics.Append(data.TokenScopes.ElementsAs(ctx, &tokenScopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		opts = append(opts, WithTokenScopes(tokenScopes))
+	}
+
+	if signingCommand != nil {
+		signer, err := NewCommandTokenSigner(signingCommand)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("signing_command"),
+				"Invalid Apple App Store Connect Signing Command",
+				fmt.Sprintf("The provider cannot use the signing command: %s", err),
+			)
+			return
+		}
+		opts = append(opts, WithTokenSigner(signer))
+	}
+
 	// Create API client
-	client, err := NewClient(issuerID, keyID, privateKey)
+	client, err := NewClient(issuerID, keyID, privateKey, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Apple App Store Connect API Client",
@@ -173,20 +563,121 @@ func (p *AppleAppStoreConnectProvider) Configure(ctx context.Context, req provid
 		return
 	}
 
-	// Make the client available for DataSources and Resources
+	// Make the client available for DataSources, Resources and EphemeralResources
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 
 	tflog.Info(ctx, "Configured Apple App Store Connect provider", map[string]interface{}{
+		"auth_mode": authMode,
 		"issuer_id": issuerID,
 		"key_id":    keyID,
 	})
 }
 
+// resolveProfile returns the profile selected by the profile attribute or the
+// APP_STORE_CONNECT_PROFILE environment variable, and whether one is selected.
+func resolveProfile(data *AppleAppStoreConnectProviderModel, diags *diag.Diagnostics) (providerProfile, bool) {
+	name := os.Getenv("APP_STORE_CONNECT_PROFILE")
+	if !data.Profile.IsNull() {
+		name = data.Profile.ValueString()
+	}
+	if name == "" {
+		return providerProfile{}, false
+	}
+
+	profilesFile := os.Getenv("APP_STORE_CONNECT_PROFILES_FILE")
+	if !data.ProfilesFile.IsNull() {
+		profilesFile = data.ProfilesFile.ValueString()
+	}
+	if profilesFile == "" {
+		profilesFile = defaultProfilesFile
+	}
+
+	profiles, err := loadProfiles(profilesFile)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("profiles_file"),
+			"Unable to Read Apple App Store Connect Profiles",
+			fmt.Sprintf("The provider cannot read the profiles file %q: %s", profilesFile, err),
+		)
+		return providerProfile{}, false
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for profileName := range profiles {
+			names = append(names, profileName)
+		}
+		slices.Sort(names)
+
+		diags.AddAttributeError(
+			path.Root("profile"),
+			"Missing Apple App Store Connect Profile",
+			fmt.Sprintf("The profile %q does not exist in the profiles file %q. Available profiles: %s.", name, profilesFile, strings.Join(names, ", ")),
+		)
+		return providerProfile{}, false
+	}
+
+	profile.Name = name
+	return profile, true
+}
+
+// resolveTransportConfig builds the transport configuration from the provider
+// configuration, reporting invalid values against their attribute.
+func resolveTransportConfig(data *AppleAppStoreConnectProviderModel, diags *diag.Diagnostics) TransportConfig {
+	var tc TransportConfig
+
+	if proxy := data.HTTPProxy.ValueString(); proxy != "" {
+		proxyURL, err := parseHTTPURL(proxy)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid HTTP Proxy",
+				fmt.Sprintf("Unable to parse http_proxy: %s", err),
+			)
+		}
+		tc.ProxyURL = proxyURL
+	}
+
+	if caBundleFile := data.CABundleFile.ValueString(); caBundleFile != "" {
+		caBundlePEM, err := os.ReadFile(caBundleFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_bundle_file"),
+				"Unable to Read CA Bundle",
+				fmt.Sprintf("Unable to read ca_bundle_file: %s", err),
+			)
+		} else if tc.RootCAs, err = newCertPool(caBundlePEM); err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_bundle_file"),
+				"Invalid CA Bundle",
+				fmt.Sprintf("Unable to load CA certificates from %s: %s", caBundleFile, err),
+			)
+		}
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		tc.InsecureSkipVerify = true
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"insecure_skip_verify is enabled, so the certificate of the App Store Connect API endpoint is not verified. Only use this setting when testing against a local stand-in of the API.",
+		)
+	}
+
+	return tc
+}
+
 func (p *AppleAppStoreConnectProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewPassTypeIDResource,
 		NewCertificateResource,
+		NewBundleIDResource,
+		NewBundleIDCapabilityResource,
+		NewProfileResource,
+		NewDeviceResource,
 	}
 }
 
@@ -195,11 +686,24 @@ func (p *AppleAppStoreConnectProvider) DataSources(ctx context.Context) []func()
 		NewPassTypeIDDataSource,
 		NewCertificateDataSource,
 		NewCertificatesDataSource,
+		NewBundleIDDataSource,
+		NewDevicesDataSource,
+	}
+}
+
+func (p *AppleAppStoreConnectProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCertificateBundleEphemeralResource,
 	}
 }
 
 func (p *AppleAppStoreConnectProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewDERToPEMFunction,
+		NewParseCertificateFunction,
+		NewPKCS12EncodeFunction,
+		NewPassTypeIdentifierValidFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
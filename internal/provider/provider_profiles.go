@@ -0,0 +1,154 @@
+// Copyright (c) TrueTickets, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultProfilesFile is the profiles file read when profiles_file is not set.
+const defaultProfilesFile = "~/.appstoreconnect/config"
+
+// providerProfile holds the credentials of one named profile in a profiles file.
+type providerProfile struct {
+	// Name is the name the profile was selected by; it is not read from the file.
+	Name string `json:"-"`
+
+	AuthMode       string `json:"auth_mode"`
+	IssuerID       string `json:"issuer_id"`
+	KeyID          string `json:"key_id"`
+	PrivateKey     string `json:"private_key"`
+	PrivateKeyPath string `json:"private_key_path"`
+}
+
+// loadProfiles reads a profiles file. The file is either a JSON object keyed by
+// profile name, or an INI file with one section per profile:
+//
+//	[team-a]
+//	issuer_id        = 69a6de70-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+//	key_id           = XXXXXXXXXX
+//	private_key_path = ~/.appstoreconnect/AuthKey_XXXXXXXXXX.p8
+func loadProfiles(name string) (map[string]providerProfile, error) {
+	data, err := os.ReadFile(expandHome(name))
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles map[string]providerProfile
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		profiles, err = parseProfilesJSON(data)
+	} else {
+		profiles, err = parseProfilesINI(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for profileName, profile := range profiles {
+		switch profile.AuthMode {
+		case "", AuthModeTeam, AuthModeIndividual:
+		default:
+			return nil, fmt.Errorf("profile %q: unsupported auth_mode %q, expected %q or %q", profileName, profile.AuthMode, AuthModeTeam, AuthModeIndividual)
+		}
+		if profile.PrivateKey != "" && profile.PrivateKeyPath != "" {
+			return nil, fmt.Errorf("profile %q: private_key and private_key_path cannot both be set", profileName)
+		}
+	}
+
+	return profiles, nil
+}
+
+// parseProfilesJSON parses a JSON profiles file, rejecting unknown keys.
+func parseProfilesJSON(data []byte) (map[string]providerProfile, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var profiles map[string]providerProfile
+	if err := decoder.Decode(&profiles); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON profiles: %w", err)
+	}
+	return profiles, nil
+}
+
+// parseProfilesINI parses an INI profiles file. Lines starting with # or ; are
+// comments, and values may be wrapped in double quotes.
+func parseProfilesINI(data []byte) (map[string]providerProfile, error) {
+	profiles := make(map[string]providerProfile)
+
+	var section string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+			if _, ok := profiles[section]; ok {
+				return nil, fmt.Errorf("line %d: duplicate profile %q", lineNumber, section)
+			}
+			profiles[section] = providerProfile{}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected \"key = value\" or \"[profile]\"", lineNumber)
+		}
+		if section == "" {
+			return nil, fmt.Errorf("line %d: %s is not in a [profile] section", lineNumber, strings.TrimSpace(key))
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			value = value[1 : len(value)-1]
+		}
+
+		profile := profiles[section]
+		switch key {
+		case "auth_mode":
+			profile.AuthMode = value
+		case "issuer_id":
+			profile.IssuerID = value
+		case "key_id":
+			profile.KeyID = value
+		case "private_key":
+			profile.PrivateKey = value
+		case "private_key_path":
+			profile.PrivateKeyPath = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", lineNumber, key)
+		}
+		profiles[section] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read INI profiles: %w", err)
+	}
+
+	return profiles, nil
+}
+
+// expandHome replaces a leading ~ in name with the home directory of the current user.
+func expandHome(name string) string {
+	if name != "~" && !strings.HasPrefix(name, "~/") {
+		return name
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return name
+	}
+	return filepath.Join(home, name[1:])
+}
@@ -5,12 +5,20 @@ package provider
 
 import (
 	"context"
+	"encoding/pem"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -71,8 +79,8 @@ func TestProviderResources(t *testing.T) {
 
 	resources := p.Resources(ctx)
 
-	if len(resources) != 2 {
-		t.Errorf("Expected 2 resources, got %d", len(resources))
+	if len(resources) != 6 {
+		t.Errorf("Expected 6 resources, got %d", len(resources))
 	}
 }
 
@@ -82,7 +90,315 @@ func TestProviderDataSources(t *testing.T) {
 
 	dataSources := p.DataSources(ctx)
 
-	if len(dataSources) != 3 {
-		t.Errorf("Expected 3 data sources, got %d", len(dataSources))
+	if len(dataSources) != 5 {
+		t.Errorf("Expected 5 data sources, got %d", len(dataSources))
+	}
+}
+
+func TestProviderEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+	p := &AppleAppStoreConnectProvider{}
+
+	ephemeralResources := p.EphemeralResources(ctx)
+
+	if len(ephemeralResources) != 1 {
+		t.Errorf("Expected 1 ephemeral resource, got %d", len(ephemeralResources))
+	}
+}
+
+func TestProviderFunctions(t *testing.T) {
+	ctx := context.Background()
+	p := &AppleAppStoreConnectProvider{}
+
+	functions := p.Functions(ctx)
+
+	if len(functions) != 4 {
+		t.Errorf("Expected 4 functions, got %d", len(functions))
+	}
+}
+
+// testProviderConfigure runs Configure with every attribute null except those in values.
+func testProviderConfigure(t *testing.T, values map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	req := provider.ConfigureRequest{Config: tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, req, resp)
+	return resp
+}
+
+func TestProviderConfigure_UnknownTransportValues(t *testing.T) {
+	for _, name := range []string{"endpoint", "http_proxy", "ca_bundle_file"} {
+		t.Run(name, func(t *testing.T) {
+			resp := testProviderConfigure(t, map[string]tftypes.Value{
+				name: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("Expected 1 error, got: %v", resp.Diagnostics)
+			}
+			if withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root(name)) {
+				t.Errorf("Expected the error on %s, got: %v", name, resp.Diagnostics.Errors()[0])
+			}
+			if resp.ResourceData != nil {
+				t.Error("Expected no client to be configured")
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_PrivateKeyPathSource(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.p8")
+	profilesFile := writeProfilesFile(t, "[team-a]\nissuer_id = issuer-a\nkey_id = AAAAAAAAAA\nprivate_key_path = "+missing+"\n")
+
+	tests := []struct {
+		name       string
+		values     map[string]tftypes.Value
+		env        map[string]string
+		wantSource string
+		wantPath   bool
+	}{
+		{
+			name: "configuration",
+			values: map[string]tftypes.Value{
+				"private_key_path": tftypes.NewValue(tftypes.String, missing),
+			},
+			wantSource: "set in private_key_path",
+			wantPath:   true,
+		},
+		{
+			name: "profile",
+			values: map[string]tftypes.Value{
+				"profile":       tftypes.NewValue(tftypes.String, "team-a"),
+				"profiles_file": tftypes.NewValue(tftypes.String, profilesFile),
+			},
+			wantSource: `set in the private_key_path of profile "team-a"`,
+		},
+		{
+			name: "environment variable",
+			env: map[string]string{
+				"APP_STORE_CONNECT_PRIVATE_KEY_PATH": missing,
+			},
+			wantSource: "set in the APP_STORE_CONNECT_PRIVATE_KEY_PATH environment variable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"APP_STORE_CONNECT_PROFILE", "APP_STORE_CONNECT_PRIVATE_KEY", "APP_STORE_CONNECT_PRIVATE_KEY_PATH", "APP_STORE_CONNECT_ACCESS_TOKEN"} {
+				t.Setenv(name, "")
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			resp := testProviderConfigure(t, tt.values)
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("Expected 1 error, got: %v", resp.Diagnostics)
+			}
+			got := resp.Diagnostics.Errors()[0]
+			if !strings.Contains(got.Detail(), tt.wantSource) {
+				t.Errorf("Expected the error to name %q, got: %s", tt.wantSource, got.Detail())
+			}
+			if _, ok := got.(diag.DiagnosticWithPath); ok != tt.wantPath {
+				t.Errorf("Attribute error = %v, want %v", ok, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestResolveTransportConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	validBundle := filepath.Join(dir, "ca.pem")
+	ca := issueTestCertificate(t, "Corporate Proxy CA", true, nil)
+	if err := os.WriteFile(validBundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600); err != nil {
+		t.Fatalf("Failed to write CA bundle: %v", err)
+	}
+
+	invalidBundle := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalidBundle, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("Failed to write CA bundle: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		data        AppleAppStoreConnectProviderModel
+		wantZero    bool
+		wantError   string
+		wantWarning string
+	}{
+		{
+			name:     "defaults",
+			wantZero: true,
+		},
+		{
+			name: "proxy and CA bundle",
+			data: AppleAppStoreConnectProviderModel{
+				HTTPProxy:    types.StringValue("http://proxy.example.com:3128"),
+				CABundleFile: types.StringValue(validBundle),
+			},
+		},
+		{
+			name: "invalid proxy",
+			data: AppleAppStoreConnectProviderModel{
+				HTTPProxy: types.StringValue("proxy.example.com:3128"),
+			},
+			wantError: "Invalid HTTP Proxy",
+		},
+		{
+			name: "missing CA bundle",
+			data: AppleAppStoreConnectProviderModel{
+				CABundleFile: types.StringValue(filepath.Join(dir, "missing.pem")),
+			},
+			wantError: "Unable to Read CA Bundle",
+		},
+		{
+			name: "invalid CA bundle",
+			data: AppleAppStoreConnectProviderModel{
+				CABundleFile: types.StringValue(invalidBundle),
+			},
+			wantError: "Invalid CA Bundle",
+		},
+		{
+			name: "insecure skip verify",
+			data: AppleAppStoreConnectProviderModel{
+				InsecureSkipVerify: types.BoolValue(true),
+			},
+			wantWarning: "TLS Certificate Verification Disabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			tc := resolveTransportConfig(&tt.data, &diags)
+
+			if tt.wantError != "" {
+				if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.wantError {
+					t.Fatalf("Expected error %q, got: %v", tt.wantError, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Expected no errors, got: %v", diags)
+			}
+			if tt.wantWarning != "" && (diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != tt.wantWarning) {
+				t.Errorf("Expected warning %q, got: %v", tt.wantWarning, diags)
+			}
+			if tc.IsZero() != tt.wantZero {
+				t.Errorf("IsZero() = %t, want %t", tc.IsZero(), tt.wantZero)
+			}
+		})
+	}
+}
+
+func TestResolveProfile(t *testing.T) {
+	profilesFile := writeProfilesFile(t, "[team-a]\nissuer_id = issuer-a\nkey_id = AAAAAAAAAA\n\n[team-b]\nissuer_id = issuer-b\nkey_id = BBBBBBBBBB\n")
+
+	tests := []struct {
+		name        string
+		data        AppleAppStoreConnectProviderModel
+		env         map[string]string
+		wantKeyID   string
+		wantError   string
+		wantProfile bool
+	}{
+		{
+			name: "no profile",
+		},
+		{
+			name: "profile attribute",
+			data: AppleAppStoreConnectProviderModel{
+				Profile:      types.StringValue("team-a"),
+				ProfilesFile: types.StringValue(profilesFile),
+			},
+			wantProfile: true,
+			wantKeyID:   "AAAAAAAAAA",
+		},
+		{
+			name: "profile environment variables",
+			env: map[string]string{
+				"APP_STORE_CONNECT_PROFILE":       "team-b",
+				"APP_STORE_CONNECT_PROFILES_FILE": profilesFile,
+			},
+			wantProfile: true,
+			wantKeyID:   "BBBBBBBBBB",
+		},
+		{
+			name: "attribute overrides environment variable",
+			data: AppleAppStoreConnectProviderModel{
+				Profile: types.StringValue("team-a"),
+			},
+			env: map[string]string{
+				"APP_STORE_CONNECT_PROFILE":       "team-b",
+				"APP_STORE_CONNECT_PROFILES_FILE": profilesFile,
+			},
+			wantProfile: true,
+			wantKeyID:   "AAAAAAAAAA",
+		},
+		{
+			name: "missing profile",
+			data: AppleAppStoreConnectProviderModel{
+				Profile:      types.StringValue("team-c"),
+				ProfilesFile: types.StringValue(profilesFile),
+			},
+			wantError: "Missing Apple App Store Connect Profile",
+		},
+		{
+			name: "missing profiles file",
+			data: AppleAppStoreConnectProviderModel{
+				Profile:      types.StringValue("team-a"),
+				ProfilesFile: types.StringValue(filepath.Join(t.TempDir(), "missing")),
+			},
+			wantError: "Unable to Read Apple App Store Connect Profiles",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("APP_STORE_CONNECT_PROFILE", "")
+			t.Setenv("APP_STORE_CONNECT_PROFILES_FILE", "")
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			var diags diag.Diagnostics
+			profile, ok := resolveProfile(&tt.data, &diags)
+
+			if tt.wantError != "" {
+				if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.wantError {
+					t.Fatalf("Expected error %q, got: %v", tt.wantError, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("Expected no errors, got: %v", diags)
+			}
+			if ok != tt.wantProfile {
+				t.Fatalf("resolveProfile() ok = %v, want %v", ok, tt.wantProfile)
+			}
+			if profile.KeyID != tt.wantKeyID {
+				t.Errorf("key_id = %q, want %q", profile.KeyID, tt.wantKeyID)
+			}
+		})
 	}
 }
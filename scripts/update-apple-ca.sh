@@ -0,0 +1,28 @@
+#!/bin/sh
+# Copyright (c) TrueTickets, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# Downloads the Apple WWDR intermediate and root certificates embedded in the
+# provider and converts them to PEM format.
+set -eu
+
+dest="$(dirname "$0")/../internal/provider/apple_ca"
+
+download() {
+	url="$1"
+	name="$2"
+
+	curl -fsSL "$url" | openssl x509 -inform DER -outform PEM -out "$dest/$name.pem"
+	printf '%s: ' "$name"
+	openssl x509 -in "$dest/$name.pem" -noout -fingerprint -sha256
+}
+
+download https://www.apple.com/certificateauthority/AppleIncRootCertificate.cer AppleIncRootCertificate
+download https://www.apple.com/certificateauthority/AppleRootCA-G2.cer AppleRootCA-G2
+download https://www.apple.com/certificateauthority/AppleRootCA-G3.cer AppleRootCA-G3
+download https://developer.apple.com/certificationauthority/AppleWWDRCA.cer AppleWWDRCA
+download https://www.apple.com/certificateauthority/AppleWWDRCAG2.cer AppleWWDRCAG2
+download https://www.apple.com/certificateauthority/AppleWWDRCAG3.cer AppleWWDRCAG3
+download https://www.apple.com/certificateauthority/AppleWWDRCAG4.cer AppleWWDRCAG4
+download https://www.apple.com/certificateauthority/AppleWWDRCAG5.cer AppleWWDRCAG5
+download https://www.apple.com/certificateauthority/AppleWWDRCAG6.cer AppleWWDRCAG6